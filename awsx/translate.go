@@ -0,0 +1,169 @@
+package awsx
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/boostgo/errorx"
+)
+
+const (
+	// Type is type of errors translated by Translate
+	Type = "AWS"
+
+	ServiceKey   = "aws_service"
+	OperationKey = "aws_operation"
+	ErrorCodeKey = "aws_error_code"
+	HostIDKey    = "aws_host_id"
+)
+
+// codeKinds classifies AWS API error codes
+var codeKinds = map[string]errorx.Kind{
+	// throttling
+	"Throttling":                             errorx.KindTooManyRequests,
+	"ThrottlingException":                    errorx.KindTooManyRequests,
+	"ThrottledException":                     errorx.KindTooManyRequests,
+	"RequestThrottled":                       errorx.KindTooManyRequests,
+	"RequestThrottledException":              errorx.KindTooManyRequests,
+	"TooManyRequestsException":               errorx.KindTooManyRequests,
+	"ProvisionedThroughputExceededException": errorx.KindTooManyRequests,
+	"RequestLimitExceeded":                   errorx.KindTooManyRequests,
+	"BandwidthLimitExceeded":                 errorx.KindTooManyRequests,
+	"LimitExceededException":                 errorx.KindTooManyRequests,
+	"SlowDown":                               errorx.KindTooManyRequests,
+	"PriorRequestNotComplete":                errorx.KindTooManyRequests,
+	"EC2ThrottledException":                  errorx.KindTooManyRequests,
+
+	// access
+	"AccessDenied":                errorx.KindForbidden,
+	"AccessDeniedException":       errorx.KindForbidden,
+	"UnauthorizedOperation":       errorx.KindForbidden,
+	"Forbidden":                   errorx.KindForbidden,
+	"UnrecognizedClientException": errorx.KindUnauthorized,
+	"InvalidClientTokenId":        errorx.KindUnauthorized,
+	"InvalidAccessKeyId":          errorx.KindUnauthorized,
+	"SignatureDoesNotMatch":       errorx.KindUnauthorized,
+	"ExpiredToken":                errorx.KindUnauthorized,
+	"ExpiredTokenException":       errorx.KindUnauthorized,
+
+	// not found
+	"NotFound":                  errorx.KindNotFound,
+	"NoSuchKey":                 errorx.KindNotFound,
+	"NoSuchBucket":              errorx.KindNotFound,
+	"NoSuchUpload":              errorx.KindNotFound,
+	"NoSuchVersion":             errorx.KindNotFound,
+	"NoSuchEntity":              errorx.KindNotFound,
+	"ResourceNotFoundException": errorx.KindNotFound,
+
+	// conflict
+	"ConditionalCheckFailedException": errorx.KindConflict,
+	"TransactionConflictException":    errorx.KindConflict,
+	"ResourceInUseException":          errorx.KindConflict,
+	"BucketAlreadyExists":             errorx.KindConflict,
+	"BucketAlreadyOwnedByYou":         errorx.KindConflict,
+	"PreconditionFailed":              errorx.KindConflict,
+
+	// invalid
+	"ValidationException":       errorx.KindInvalid,
+	"ValidationError":           errorx.KindInvalid,
+	"InvalidParameterValue":     errorx.KindInvalid,
+	"InvalidParameterException": errorx.KindInvalid,
+	"InvalidArgument":           errorx.KindInvalid,
+	"InvalidRequest":            errorx.KindInvalid,
+	"EntityTooLarge":            errorx.KindInvalid,
+
+	// server side
+	"InternalError":               errorx.KindUnavailable,
+	"InternalFailure":             errorx.KindUnavailable,
+	"InternalServerError":         errorx.KindUnavailable,
+	"ServiceUnavailable":          errorx.KindUnavailable,
+	"ServiceUnavailableException": errorx.KindUnavailable,
+	"RequestTimeout":              errorx.KindTimeout,
+	"RequestTimeoutException":     errorx.KindTimeout,
+}
+
+// apiError is smithy.APIError
+type apiError interface {
+	error
+	ErrorCode() string
+}
+
+// Translate translates AWS SDK (v2, smithy) error to classified custom error.
+//
+// Error code of smithy.APIError decides kind: throttling - KindTooManyRequests, access denied - KindForbidden,
+// NoSuchKey/ResourceNotFoundException - KindNotFound, conditional check failures - KindConflict and so on.
+// Unknown codes are classified by HTTP status code of the response.
+// Service and operation (smithy.OperationError), request ID and S3 host ID (awshttp.ResponseError) are stored in the context.
+//
+// Package does not depend on AWS SDK, errors are recognized by their methods.
+// If error is not recognized - it is returned as is. If provided error is nil - return nil
+func Translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var api apiError
+	if !errors.As(err, &api) {
+		return err
+	}
+
+	kind, ok := codeKinds[api.ErrorCode()]
+	if !ok {
+		kind = statusKind(err)
+	}
+
+	translated := errorx.Get(errorx.Wrapped(Type, err, api.ErrorCode())).
+		SetKind(kind).
+		AddContext(ErrorCodeKey, api.ErrorCode())
+
+	var operation interface {
+		Service() string
+		Operation() string
+	}
+	if errors.As(err, &operation) {
+		translated = translated.
+			AddContext(ServiceKey, operation.Service()).
+			AddContext(OperationKey, operation.Operation())
+	}
+
+	var request interface{ ServiceRequestID() string }
+	if errors.As(err, &request) && request.ServiceRequestID() != "" {
+		translated = translated.AddContext(errorx.RequestIDKey, request.ServiceRequestID())
+	}
+
+	var host interface{ ServiceHostID() string }
+	if errors.As(err, &host) && host.ServiceHostID() != "" {
+		translated = translated.AddContext(HostIDKey, host.ServiceHostID())
+	}
+
+	return translated
+}
+
+// statusKind classifies error by HTTP status code of the response (smithyhttp.ResponseError)
+func statusKind(err error) errorx.Kind {
+	var response interface{ HTTPStatusCode() int }
+	if !errors.As(err, &response) {
+		return errorx.KindUnknown
+	}
+
+	switch status := response.HTTPStatusCode(); {
+	case status == http.StatusBadRequest:
+		return errorx.KindInvalid
+	case status == http.StatusUnauthorized:
+		return errorx.KindUnauthorized
+	case status == http.StatusForbidden:
+		return errorx.KindForbidden
+	case status == http.StatusNotFound:
+		return errorx.KindNotFound
+	case status == http.StatusConflict, status == http.StatusPreconditionFailed:
+		return errorx.KindConflict
+	case status == http.StatusTooManyRequests:
+		return errorx.KindTooManyRequests
+	case status == http.StatusGatewayTimeout, status == http.StatusRequestTimeout:
+		return errorx.KindTimeout
+	case status >= http.StatusInternalServerError:
+		return errorx.KindUnavailable
+	default:
+		return errorx.KindUnknown
+	}
+}
@@ -0,0 +1,92 @@
+package brokerx
+
+import (
+	"reflect"
+	"regexp"
+
+	"github.com/boostgo/errorx"
+)
+
+const (
+	// AMQPType is type of errors translated by TranslateAMQP
+	AMQPType = "AMQP"
+
+	QueueKey    = "queue"
+	ExchangeKey = "exchange"
+	AMQPCodeKey = "amqp_code"
+)
+
+// amqpCodes classifies AMQP 0-9-1 reply codes
+var amqpCodes = map[int64]failure{
+	311: {message: "message too large", kind: errorx.KindInvalid},
+	312: {message: "no route", kind: errorx.KindNotFound},
+	313: {message: "no consumers", kind: errorx.KindUnavailable, retryable: true},
+	320: {message: "connection forced", kind: errorx.KindUnavailable, retryable: true},
+	402: {message: "invalid path", kind: errorx.KindInvalid},
+	403: {message: "access refused", kind: errorx.KindForbidden},
+	404: {message: "not found", kind: errorx.KindNotFound},
+	405: {message: "resource locked", kind: errorx.KindConflict},
+	406: {message: "precondition failed", kind: errorx.KindConflict},
+	501: {message: "frame error", kind: errorx.KindInternal},
+	502: {message: "syntax error", kind: errorx.KindInternal},
+	503: {message: "command invalid", kind: errorx.KindInternal},
+	504: {message: "channel closed", kind: errorx.KindUnavailable, retryable: true},
+	505: {message: "unexpected frame", kind: errorx.KindInternal},
+	506: {message: "resource error", kind: errorx.KindUnavailable, retryable: true},
+	530: {message: "not allowed", kind: errorx.KindForbidden},
+	540: {message: "not implemented", kind: errorx.KindInternal},
+	541: {message: "broker internal error", kind: errorx.KindUnavailable, retryable: true},
+}
+
+// amqpReasonResource extracts queue/exchange name from reply text, like "NOT_FOUND - no queue 'orders' in vhost '/'"
+var amqpReasonResource = regexp.MustCompile(`\b(queue|exchange) '([^']*)'`)
+
+// TranslateAMQP translates amqp091-go (streadway/amqp) errors to classified custom error with AMQPType type.
+//
+// Closed channels/connections and broker failures get KindUnavailable and are marked as retryable,
+// missing queue/exchange - KindNotFound, message too large - KindInvalid, access refused - KindForbidden.
+// Queue or exchange name from the reply text is stored in the context by QueueKey or ExchangeKey.
+//
+// Package does not depend on AMQP client, errors are recognized by their types.
+// If error is not recognized - it is returned as is. If provided error is nil - return nil
+func TranslateAMQP(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	amqpErr, found := findError(err, "amqp", "Error")
+	if !found {
+		return err
+	}
+
+	code, ok := field(amqpErr, "Code")
+	if !ok || !code.CanInt() {
+		return err
+	}
+
+	classified, ok := amqpCodes[code.Int()]
+	if !ok {
+		return err
+	}
+
+	// broker tells if operation could be recovered
+	if recoverable, ok := field(amqpErr, "Recover"); ok && recoverable.Kind() == reflect.Bool && recoverable.Bool() {
+		classified.retryable = true
+	}
+
+	translated := translate(AMQPType, err, classified).
+		AddContext(AMQPCodeKey, code.Int())
+
+	if reason, ok := field(amqpErr, "Reason"); ok && reason.Kind() == reflect.String {
+		if match := amqpReasonResource.FindStringSubmatch(reason.String()); match != nil {
+			key := QueueKey
+			if match[1] == "exchange" {
+				key = ExchangeKey
+			}
+
+			translated = translated.AddContext(key, match[2])
+		}
+	}
+
+	return translated
+}
@@ -0,0 +1,106 @@
+package brokerx
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/boostgo/errorx"
+)
+
+// failure is classification of the broker error
+type failure struct {
+	message   string
+	kind      errorx.Kind
+	retryable bool
+}
+
+// translate wraps provided error by classified custom one
+func translate(errType string, err error, classified failure) *errorx.Error {
+	translated := errorx.Get(errorx.Wrapped(errType, err, classified.message)).
+		SetKind(classified.kind)
+	if classified.retryable {
+		translated = translated.SetRetryable(true)
+	}
+
+	return translated
+}
+
+// findError searches error in the chain which type is declared in package containing provided path part and named by one of names
+func findError(err error, pkg string, names ...string) (reflect.Value, bool) {
+	var found reflect.Value
+	walk(err, func(err error) bool {
+		value := reflect.ValueOf(err)
+		typ := value.Type()
+		if typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+			value = value.Elem()
+		}
+
+		if strings.Contains(typ.PkgPath(), pkg) && slices.Contains(names, typ.Name()) {
+			found = value
+			return false
+		}
+
+		return true
+	})
+
+	return found, found.IsValid()
+}
+
+// walk traverses chain of the error (including joined errors). Traversal stops when function returns false
+func walk(err error, fn func(error) bool) bool {
+	if err == nil {
+		return true
+	}
+
+	if !fn(err) {
+		return false
+	}
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		return walk(wrapped.Unwrap(), fn)
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapped.Unwrap() {
+			if !walk(inner, fn) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// field returns exported field of the struct (or pointer to struct) by name
+func field(value reflect.Value, name string) (reflect.Value, bool) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	found := value.FieldByName(name)
+	if !found.IsValid() || !found.CanInterface() {
+		return reflect.Value{}, false
+	}
+
+	return found, true
+}
+
+// errorIs checks if error chain contains error with provided text (for sentinels of not imported packages)
+func errorIs(err error, text string) bool {
+	found := false
+	walk(err, func(err error) bool {
+		found = err.Error() == text
+		return !found
+	})
+
+	return found
+}
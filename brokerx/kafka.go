@@ -0,0 +1,124 @@
+package brokerx
+
+import (
+	"reflect"
+
+	"github.com/boostgo/errorx"
+)
+
+const (
+	// KafkaType is type of errors translated by TranslateKafka
+	KafkaType = "Kafka"
+
+	TopicKey     = "topic"
+	PartitionKey = "partition"
+	KafkaCodeKey = "kafka_code"
+)
+
+// kafkaCodes classifies Kafka protocol error codes (same for sarama.KError and kafka-go Error)
+var kafkaCodes = map[int64]failure{
+	3:  {message: "topic not found", kind: errorx.KindNotFound},
+	5:  {message: "leader not available", kind: errorx.KindUnavailable, retryable: true},
+	6:  {message: "not leader for partition", kind: errorx.KindUnavailable, retryable: true},
+	7:  {message: "request timed out", kind: errorx.KindTimeout, retryable: true},
+	8:  {message: "broker not available", kind: errorx.KindUnavailable, retryable: true},
+	10: {message: "message too large", kind: errorx.KindInvalid},
+	13: {message: "network exception", kind: errorx.KindUnavailable, retryable: true},
+	14: {message: "coordinator loading in progress", kind: errorx.KindUnavailable, retryable: true},
+	15: {message: "coordinator not available", kind: errorx.KindUnavailable, retryable: true},
+	16: {message: "not coordinator", kind: errorx.KindUnavailable, retryable: true},
+	17: {message: "invalid topic", kind: errorx.KindInvalid},
+	18: {message: "record list too large", kind: errorx.KindInvalid},
+	19: {message: "not enough replicas", kind: errorx.KindUnavailable, retryable: true},
+	20: {message: "not enough replicas after append", kind: errorx.KindUnavailable, retryable: true},
+	29: {message: "topic authorization failed", kind: errorx.KindForbidden},
+	30: {message: "group authorization failed", kind: errorx.KindForbidden},
+	31: {message: "cluster authorization failed", kind: errorx.KindForbidden},
+	36: {message: "topic already exists", kind: errorx.KindConflict},
+	58: {message: "sasl authentication failed", kind: errorx.KindUnauthorized},
+}
+
+// kafkaSentinels classifies sentinel errors of sarama which are not protocol codes
+var kafkaSentinels = map[string]failure{
+	"kafka: client has run out of available brokers to talk to":                  {message: "broker not available", kind: errorx.KindUnavailable, retryable: true},
+	"kafka: tried to use a client that was closed":                               {message: "client closed", kind: errorx.KindUnavailable},
+	"kafka: message was too large, server rejected it to avoid allocation error": {message: "message too large", kind: errorx.KindInvalid},
+}
+
+// TranslateKafka translates sarama and kafka-go errors to classified custom error with KafkaType type.
+//
+// Broker-unavailable conditions (leader not available, not enough replicas, out of brokers) get KindUnavailable
+// and are marked as retryable, unknown topic - KindNotFound, message too large - KindInvalid.
+// Topic and partition of sarama.ProducerError/ConsumerError (or kafka-go Message) are stored in the context.
+//
+// Package does not depend on Kafka clients, errors are recognized by their types.
+// If error is not recognized - it is returned as is. If provided error is nil - return nil
+func TranslateKafka(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		classified failure
+		code       int64
+		ok         bool
+	)
+
+	if value, found := findError(err, "sarama", "KError"); found {
+		code = value.Int()
+	} else if value, found = findError(err, "kafka-go", "Error"); found && value.CanInt() {
+		code = value.Int()
+	} else if _, found = findError(err, "kafka-go", "MessageTooLargeError"); found {
+		code = 10
+	}
+
+	if classified, ok = kafkaCodes[code]; !ok {
+		for text, sentinel := range kafkaSentinels {
+			if errorIs(err, text) {
+				classified, ok = sentinel, true
+				break
+			}
+		}
+	}
+
+	if !ok {
+		return err
+	}
+
+	translated := translate(KafkaType, err, classified)
+	if code != 0 {
+		translated = translated.AddContext(KafkaCodeKey, code)
+	}
+
+	return withPartition(translated, err)
+}
+
+// withPartition adds topic and partition of the failed message to the context
+func withPartition(translated *errorx.Error, err error) *errorx.Error {
+	source, found := findError(err, "sarama", "ProducerError", "ConsumerError")
+	if !found {
+		source, found = findError(err, "kafka-go", "MessageTooLargeError")
+	}
+
+	if !found {
+		return translated
+	}
+
+	// sarama.ProducerError and kafka-go MessageTooLargeError keep topic in the message
+	for _, name := range []string{"Msg", "Message"} {
+		if message, ok := field(source, name); ok {
+			source = message
+			break
+		}
+	}
+
+	if topic, ok := field(source, "Topic"); ok && topic.Kind() == reflect.String && topic.String() != "" {
+		translated = translated.AddContext(TopicKey, topic.String())
+	}
+
+	if partition, ok := field(source, "Partition"); ok && partition.CanInt() {
+		translated = translated.AddContext(PartitionKey, partition.Int())
+	}
+
+	return translated
+}
@@ -0,0 +1,185 @@
+package errorx
+
+import "errors"
+
+const (
+	// maxChainDepth is max depth of traversed chain. Chain could be deeper only if it has cycle
+	maxChainDepth = 1024
+
+	cycleMessage = "<cycle>"
+)
+
+// walkChain traverses whole chain of the error (depth-first, like errors.Is) and calls provided function
+// for every error in the chain. Traversal stops when function returns false.
+//
+// Errors of pkg/errors convention (Cause() error without Unwrap) and aggregates of
+// hashicorp/go-multierror and uber multierr are traversed too.
+//
+// Traversal is bounded by maxChainDepth, so chains with cycles do not loop forever
+func walkChain(err error, fn func(error) bool) bool {
+	return walkChainDepth(err, fn, 0)
+}
+
+func walkChainDepth(err error, fn func(error) bool, depth int) bool {
+	if err == nil || depth > maxChainDepth {
+		return true
+	}
+
+	if !fn(err) {
+		return false
+	}
+
+	if errs, ok := aggregated(err); ok {
+		for _, inner := range errs {
+			if !walkChainDepth(inner, fn, depth+1) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	switch wrapped := err.(type) {
+	case *Error:
+		return walkChainDepth(wrapped.innerError, fn, depth+1)
+	case interface{ Unwrap() error }:
+		return walkChainDepth(wrapped.Unwrap(), fn, depth+1)
+	case interface{ Cause() error }:
+		return walkChainDepth(wrapped.Cause(), fn, depth+1)
+	}
+
+	return true
+}
+
+// cyclic checks if the error is contained in the chain of its own inner error
+// (error was set as its own inner error or errors wrap each other)
+func (err *Error) cyclic() bool {
+	if err.innerError == nil {
+		return false
+	}
+
+	found := false
+	walkChain(err.innerError, func(inner error) bool {
+		found = inner == error(err)
+		return !found
+	})
+
+	return found
+}
+
+// selfWrapped is cheap version of cyclic: checks only linear part of the chain (without joined errors)
+func (err *Error) selfWrapped() bool {
+	inner := err.innerError
+	for depth := 0; inner != nil && depth <= maxChainDepth; depth++ {
+		switch wrapped := inner.(type) {
+		case *Error:
+			if wrapped == err {
+				return true
+			}
+
+			inner = wrapped.innerError
+		case interface{ Unwrap() error }:
+			inner = wrapped.Unwrap()
+		case interface{ Cause() error }:
+			inner = wrapped.Cause()
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// HasContext checks if any custom error in the whole chain has context value by provided key
+func HasContext(err error, key string) bool {
+	_, ok := ContextValue(err, key)
+	return ok
+}
+
+// ContextValue searches context value by provided key in the whole chain of the error.
+//
+// The first found value (from outer errors to inner ones) is returned
+func ContextValue(err error, key string) (any, bool) {
+	var (
+		value any
+		found bool
+	)
+
+	walkChain(err, func(err error) bool {
+		custom, ok := err.(*Error)
+		if !ok {
+			return true
+		}
+
+		value, found = custom.contextValue(key)
+		return !found
+	})
+
+	return value, found
+}
+
+// Flatten expands joined/multi errors (hashicorp/go-multierror and uber multierr too) and custom errors inner chains (recursively) into flat list of leaf errors.
+//
+// Custom error without inner error is a leaf. Built-in errors (even wrapped by fmt.Errorf) are leaves too
+func Flatten(err error) []error {
+	if err == nil {
+		return []error{}
+	}
+
+	if custom, ok := err.(*Error); ok {
+		if custom.innerError == nil {
+			return []error{custom}
+		}
+
+		return Flatten(custom.innerError)
+	}
+
+	errs, ok := aggregated(err)
+	if !ok {
+		return []error{err}
+	}
+
+	leaves := make([]error, 0)
+	for _, inner := range errs {
+		leaves = append(leaves, Flatten(inner)...)
+	}
+
+	return leaves
+}
+
+// causeIs searches target behind errors which errors.Is does not traverse:
+// pkg/errors convention (Cause() error without Unwrap) and aggregates without Unwrap() []error (old multierror versions)
+func causeIs(err, target error) bool {
+	found := false
+	walkChain(err, func(err error) bool {
+		switch err.(type) {
+		case interface{ Unwrap() error }, interface{ Unwrap() []error }:
+			return true
+		}
+
+		if caused, ok := err.(interface{ Cause() error }); ok && caused.Cause() != nil {
+			found = errors.Is(caused.Cause(), target)
+		}
+
+		if errs, ok := aggregated(err); ok {
+			for _, inner := range errs {
+				if found = inner != nil && errors.Is(inner, target); found {
+					break
+				}
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// Cause returns inner error (pkg/errors convention), so code migrating from pkg/errors keeps traversing the chain
+func (err *Error) Cause() error {
+	if err.selfWrapped() {
+		return nil
+	}
+
+	return err.innerError
+}
@@ -0,0 +1,86 @@
+package errorx
+
+import "slices"
+
+const (
+	CircuitOpenTag = "circuit_open"
+)
+
+// MarkCircuitOpen returns copy of provided error tagged by CircuitOpenTag.
+//
+// If error has no kind, KindUnavailable is set. If provided error is nil - new "circuit breaker is open" error is returned
+func MarkCircuitOpen(err error) error {
+	if err == nil {
+		return New("circuit breaker is open").
+			SetKind(KindUnavailable).
+			AddTag(CircuitOpenTag)
+	}
+
+	marked := detach(err).AddTag(CircuitOpenTag)
+	if KindOf(err) == KindUnknown {
+		marked = marked.SetKind(KindUnavailable)
+	}
+
+	return marked
+}
+
+// IsCircuitOpen checks if error was rejected by open circuit breaker (see MarkCircuitOpen)
+func IsCircuitOpen(err error) bool {
+	return HasTag(err, CircuitOpenTag)
+}
+
+// BreakerClassifier decides if error should count as failure for circuit breaker.
+//
+// Could be used with breaker libraries, for example sony/gobreaker:
+//
+//	classifier := errorx.NewBreakerClassifier()
+//	breaker := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+//		IsSuccessful: classifier.IsSuccessful,
+//	})
+type BreakerClassifier struct {
+	// IgnoreKinds are kinds of errors which do not count as failures (client-side errors)
+	IgnoreKinds []Kind
+	// IgnoreTags are tags of errors which do not count as failures
+	IgnoreTags []string
+}
+
+// NewBreakerClassifier creates classifier which ignores client-side errors
+// (invalid, unauthorized, forbidden, not found, conflict and canceled kinds)
+func NewBreakerClassifier() BreakerClassifier {
+	return BreakerClassifier{
+		IgnoreKinds: []Kind{
+			KindInvalid,
+			KindUnauthorized,
+			KindForbidden,
+			KindNotFound,
+			KindConflict,
+			KindCanceled,
+		},
+	}
+}
+
+// IsFailure returns true if error should count as failure.
+//
+// Nil errors, errors rejected by open circuit, errors with ignored kinds or tags are not failures
+func (c BreakerClassifier) IsFailure(err error) bool {
+	if err == nil || IsCircuitOpen(err) {
+		return false
+	}
+
+	if slices.Contains(c.IgnoreKinds, KindOf(err)) {
+		return false
+	}
+
+	for _, tag := range c.IgnoreTags {
+		if HasTag(err, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuccessful is opposite of IsFailure
+func (c BreakerClassifier) IsSuccessful(err error) bool {
+	return !c.IsFailure(err)
+}
@@ -0,0 +1,98 @@
+package errorx
+
+import (
+	"maps"
+	"slices"
+)
+
+// Clone deep copies whole chain of the error: levels, context, trace, suppressed and inner custom errors
+// (joined errors and MultiError are copied too). Unlike Copy, levels are not collapsed to one and identity is kept,
+// so clone is equal to the source and could be changed without affecting it.
+//
+// Context maps, groups and slices of values are copied, other values and built-in errors are shared.
+// If provided error is built-in - it is wrapped by new custom error. If provided error is nil - return nil
+func Clone(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	custom, ok := err.(*Error)
+	if !ok {
+		return promote(err)
+	}
+
+	return custom.deepClone()
+}
+
+// deepClone returns deep copy of the error (see Clone)
+func (err *Error) deepClone() *Error {
+	cloned := err.clone()
+	cloned.innerError = cloneError(err.innerError)
+	for i, suppressed := range cloned.suppressed {
+		cloned.suppressed[i] = cloneError(suppressed)
+	}
+
+	for i := range cloned.fields {
+		cloned.fields[i].value = cloneValue(cloned.fields[i].value)
+	}
+
+	if err.userMessage != nil {
+		userMessage := *err.userMessage
+		userMessage.args = slices.Clone(userMessage.args)
+		cloned.userMessage = &userMessage
+	}
+
+	return cloned
+}
+
+// cloneError deep copies custom errors and containers of errors, built-in errors are returned as is
+func cloneError(err error) error {
+	switch typed := err.(type) {
+	case *Error:
+		return typed.deepClone()
+	case *joinErrors:
+		return &joinErrors{errors: cloneErrors(typed.errors)}
+	case *MultiError:
+		return &MultiError{errors: cloneErrors(typed.errors)}
+	default:
+		return err
+	}
+}
+
+// cloneErrors deep copies list of errors
+func cloneErrors(errs []error) []error {
+	if errs == nil {
+		return nil
+	}
+
+	cloned := make([]error, len(errs))
+	for i, err := range errs {
+		cloned[i] = cloneError(err)
+	}
+
+	return cloned
+}
+
+// cloneValue copies context maps, groups and slices of values recursively
+func cloneValue(value any) any {
+	switch typed := value.(type) {
+	case Group:
+		return Group(cloneValue(map[string]any(typed)).(map[string]any))
+	case map[string]any:
+		cloned := maps.Clone(typed)
+		for key, inner := range cloned {
+			cloned[key] = cloneValue(inner)
+		}
+
+		return cloned
+	case []any:
+		cloned := slices.Clone(typed)
+		for i, inner := range cloned {
+			cloned[i] = cloneValue(inner)
+		}
+
+		return cloned
+	default:
+		return value
+	}
+}
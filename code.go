@@ -0,0 +1,34 @@
+package errorx
+
+// Code is stable machine-readable error code, like "E1001" or "USER_NOT_FOUND"
+type Code string
+
+// String returns code as string
+func (c Code) String() string {
+	return string(c)
+}
+
+// Code returns code of current error
+func (err *Error) Code() Code {
+	return err.code
+}
+
+// SetCode sets code of current error
+func (err *Error) SetCode(code Code) *Error {
+	err = err.derive()
+	err.code = code
+	return err
+}
+
+// CodeOf returns first not empty code found in the chain of custom errors.
+//
+// If provided error is built-in or has no code - return empty code
+func CodeOf(err error) Code {
+	for custom, ok := TryGet(err); ok; custom, ok = TryGet(custom.innerError) {
+		if custom.code != "" {
+			return custom.code
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,99 @@
+package errorx
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+const (
+	OriginKey = "origin"
+)
+
+// Collector collects errors reported concurrently from many goroutines.
+//
+// Zero value is ready to use:
+//
+//	var collector errorx.Collector
+//	for _, job := range jobs {
+//		go func() {
+//			defer wg.Done()
+//			collector.Add(process(job))
+//		}()
+//	}
+//	wg.Wait()
+//	return collector.Result()
+type Collector struct {
+	mx     sync.Mutex
+	errors []error
+}
+
+// Add adds error to the collector. Nil errors are ignored.
+//
+// Error is annotated by OriginKey context with file and line where Add was called.
+// Method is safe for concurrent use
+func (c *Collector) Add(err error) {
+	if err == nil {
+		return
+	}
+
+	annotated := withOrigin(err, 2)
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.errors = append(c.errors, annotated)
+}
+
+// Len returns count of collected errors
+func (c *Collector) Len() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return len(c.errors)
+}
+
+// Result returns MultiError with all collected errors or nil if there are no errors
+func (c *Collector) Result() error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return Append(nil, c.errors...).ErrorOrNil()
+}
+
+// Collect drains provided error channel until it is closed or context is canceled.
+//
+// Returns MultiError with all received errors or nil if there were no errors.
+// If context is canceled, context error is added to the result
+func Collect(ctx context.Context, errs <-chan error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var result *MultiError
+	for {
+		select {
+		case <-ctx.Done():
+			return Append(result, ctx.Err()).ErrorOrNil()
+		case err, ok := <-errs:
+			if !ok {
+				return result.ErrorOrNil()
+			}
+
+			result = Append(result, err)
+		}
+	}
+}
+
+// withOrigin returns copy of the error annotated by caller location.
+//
+// Provided error is not modified, because it could be shared between goroutines
+func withOrigin(err error, skip int) error {
+	_, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return err
+	}
+
+	return detach(err).AddContext(OriginKey, file+":"+strconv.Itoa(line))
+}
@@ -0,0 +1,141 @@
+package errorx
+
+import (
+	"reflect"
+	"slices"
+)
+
+// CompareOption sets up comparison of Equal
+type CompareOption func(options *compareOptions)
+
+type compareOptions struct {
+	ignoreTrace      bool
+	ignoreTimestamps bool
+	ignoreKeys       map[string]struct{}
+}
+
+// IgnoreTrace ignores stack traces of compared errors
+func IgnoreTrace() CompareOption {
+	return func(options *compareOptions) {
+		options.ignoreTrace = true
+	}
+}
+
+// IgnoreTimestamps ignores creation time of compared errors
+func IgnoreTimestamps() CompareOption {
+	return func(options *compareOptions) {
+		options.ignoreTimestamps = true
+	}
+}
+
+// IgnoreContextKeys ignores provided context keys of compared errors
+func IgnoreContextKeys(keys ...string) CompareOption {
+	return func(options *compareOptions) {
+		for _, key := range keys {
+			options.ignoreKeys[key] = struct{}{}
+		}
+	}
+}
+
+// Equal compares errors field by field (types, messages, code, kind, tags, context, trace, creation time,
+// inner and suppressed errors) including the whole chain. Built-in errors are compared by text.
+//
+// Volatile fields could be ignored by options, which is useful for assertions in tests:
+//
+//	errorx.Equal(got, want, errorx.IgnoreTrace(), errorx.IgnoreTimestamps(), errorx.IgnoreContextKeys("request_id"))
+func Equal(a, b error, opts ...CompareOption) bool {
+	options := compareOptions{
+		ignoreKeys: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	return equalErrors(a, b, &options)
+}
+
+// Equal compares current error with target by Equal ignoring creation time.
+//
+// Method has form which is recognized by go-cmp (cmp.Equal), so errors could be compared in table tests.
+// testify (assert.Equal, assert.ObjectsAreEqual) compares by reflect.DeepEqual and never calls this method,
+// and errors created separately are never deeply equal (creation time, caches). Compare their exported levels instead:
+//
+//	assert.Equal(t, errorx.Export(want), errorx.Export(got))
+func (err *Error) Equal(target error) bool {
+	return Equal(err, target, IgnoreTimestamps())
+}
+
+func equalErrors(a, b error, options *compareOptions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	aCustom, aIsCustom := a.(*Error)
+	bCustom, bIsCustom := b.(*Error)
+	if aIsCustom != bIsCustom {
+		return false
+	}
+
+	if aIsCustom {
+		return equalCustom(aCustom, bCustom, options)
+	}
+
+	aMulti, aIsMulti := a.(interface{ Unwrap() []error })
+	bMulti, bIsMulti := b.(interface{ Unwrap() []error })
+	if aIsMulti && bIsMulti {
+		return slices.EqualFunc(aMulti.Unwrap(), bMulti.Unwrap(), func(a, b error) bool {
+			return equalErrors(a, b, options)
+		})
+	}
+
+	return a == b || a.Error() == b.Error()
+}
+
+func equalCustom(a, b *Error, options *compareOptions) bool {
+	if a == b {
+		return true
+	}
+
+	if !slices.Equal(a.levels, b.levels) ||
+		a.code != b.code ||
+		a.kind != b.kind ||
+		a.retry != b.retry ||
+		a.retryAfter != b.retryAfter ||
+		!slices.Equal(a.tags, b.tags) {
+		return false
+	}
+
+	if !options.ignoreTrace && !slices.Equal(a.trace, b.trace) {
+		return false
+	}
+
+	if !options.ignoreTimestamps && !a.createdAt.Equal(b.createdAt) {
+		return false
+	}
+
+	if !reflect.DeepEqual(comparedContext(a, options), comparedContext(b, options)) {
+		return false
+	}
+
+	if !slices.EqualFunc(a.suppressed, b.suppressed, func(a, b error) bool {
+		return equalErrors(a, b, options)
+	}) {
+		return false
+	}
+
+	return equalErrors(a.innerError, b.innerError, options)
+}
+
+// comparedContext returns context of the error without ignored keys
+func comparedContext(err *Error, options *compareOptions) map[string]any {
+	context := make(map[string]any, len(err.fields))
+	for _, f := range err.fields {
+		if _, ignored := options.ignoreKeys[f.key]; !ignored {
+			context[f.key] = f.value
+		}
+	}
+
+	return context
+}
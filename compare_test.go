@@ -0,0 +1,78 @@
+package errorx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// objectsAreEqual mirrors assert.ObjectsAreEqual of testify
+func objectsAreEqual(expected, actual any) bool {
+	if expected == nil || actual == nil {
+		return expected == actual
+	}
+
+	exp, ok := expected.([]byte)
+	if !ok {
+		return reflect.DeepEqual(expected, actual)
+	}
+
+	act, ok := actual.([]byte)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(exp, act)
+}
+
+func TestExportObjectsAreEqual(t *testing.T) {
+	newErr := func(id int) error {
+		err := error(New("no rows").SetType("SQL").AddContext("query", "select"))
+		Wrap("Repo", &err, "get user", map[string]any{"id": id})
+		return err
+	}
+
+	tests := []struct {
+		name string
+		a, b error
+		want bool
+	}{
+		{
+			name: "separately created equal errors",
+			a:    newErr(1),
+			b:    newErr(1),
+			want: true,
+		},
+		{
+			name: "different context",
+			a:    newErr(1),
+			b:    newErr(2),
+			want: false,
+		},
+		{
+			name: "different inner error",
+			a:    Wrapped("Repo", errors.New("a"), "get user"),
+			b:    Wrapped("Repo", errors.New("b"), "get user"),
+			want: false,
+		},
+		{
+			name: "nil errors",
+			a:    nil,
+			b:    nil,
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := objectsAreEqual(Export(tt.a), Export(tt.b)); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+
+			if got := Equal(tt.a, tt.b, IgnoreTimestamps(), IgnoreTrace()); got != tt.want {
+				t.Fatalf("Equal got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
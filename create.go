@@ -0,0 +1,64 @@
+package errorx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// CreateHook is callback which is called on every created error
+type CreateHook func(err *Error)
+
+var createHooks struct {
+	mx    sync.Mutex
+	hooks atomic.Pointer[[]CreateHook]
+}
+
+// OnCreate registers global hook which is called on every created error: by New, NewWith, E, Copy,
+// Promote, NewHere, templates (see Template) and Wrap of built-in error. Sentinels created by Define are not reported.
+//
+// Hook gets error right after construction, so for errors built by fluent chain (New(...).SetType(...))
+// only message is available. Use NewWith, E or templates if hook needs type, kind or code:
+//
+//	errorx.OnCreate(func(err *errorx.Error) {
+//		errorsTotal.WithLabelValues(err.Kind().String()).Inc()
+//	})
+//
+// Hooks must be fast and must not create errors by themselves
+func OnCreate(hook CreateHook) {
+	if hook == nil {
+		return
+	}
+
+	createHooks.mx.Lock()
+	defer createHooks.mx.Unlock()
+
+	hooks := make([]CreateHook, 0)
+	if current := createHooks.hooks.Load(); current != nil {
+		hooks = append(hooks, *current...)
+	}
+	hooks = append(hooks, hook)
+
+	createHooks.hooks.Store(&hooks)
+}
+
+// notifyCreate calls all registered create hooks and returns provided error. Panic inside hook is ignored
+func notifyCreate(err *Error) *Error {
+	publish(EventCreate, err)
+
+	hooks := createHooks.hooks.Load()
+	if hooks == nil {
+		return err
+	}
+
+	for _, hook := range *hooks {
+		func() {
+			defer func() {
+				_ = recover()
+			}()
+
+			hook(err)
+		}()
+	}
+
+	return err
+}
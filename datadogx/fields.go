@@ -0,0 +1,135 @@
+package datadogx
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/boostgo/convert"
+	"github.com/boostgo/errorx"
+)
+
+const (
+	KeyErrorKind    = "error.kind"
+	KeyErrorMessage = "error.message"
+	KeyErrorStack   = "error.stack"
+	KeyTraceID      = "dd.trace_id"
+	KeySpanID       = "dd.span_id"
+)
+
+// Fields returns attributes which Datadog error tracking expects: "error.kind", "error.message", "error.stack"
+// and trace correlation IDs "dd.trace_id", "dd.span_id" (taken from errorx.TraceIDKey and errorx.SpanIDKey context).
+//
+// Hex (OpenTelemetry) IDs are converted to decimal format of Datadog. Empty attributes are omitted.
+// If provided error is nil - return nil
+func Fields(err error) map[string]string {
+	if err == nil {
+		return nil
+	}
+
+	fields := map[string]string{
+		KeyErrorKind:    kindOf(err),
+		KeyErrorMessage: err.Error(),
+	}
+
+	custom, ok := errorx.TryGet(err)
+	if !ok {
+		return fields
+	}
+
+	fields[KeyErrorMessage] = message(custom)
+	if trace := custom.Trace(); len(trace) > 0 {
+		fields[KeyErrorStack] = strings.Join(trace, "\n")
+	}
+
+	if traceID := errorx.TraceID(err); traceID != "" {
+		fields[KeyTraceID] = decimalID(traceID)
+	}
+
+	if spanID, ok := errorx.ContextValue(err, errorx.SpanIDKey); ok {
+		if id := convert.String(spanID); id != "" {
+			fields[KeySpanID] = decimalID(id)
+		}
+	}
+
+	return fields
+}
+
+// Attrs returns Fields as slog attributes:
+//
+//	slog.Error("request failed", datadogx.Attrs(err)...)
+func Attrs(err error) []any {
+	fields := Fields(err)
+	attrs := make([]any, 0, len(fields))
+	for _, key := range []string{KeyErrorKind, KeyErrorMessage, KeyErrorStack, KeyTraceID, KeySpanID} {
+		if value, ok := fields[key]; ok {
+			attrs = append(attrs, slog.String(key, value))
+		}
+	}
+
+	return attrs
+}
+
+// message returns messages of custom error followed by text of its inner errors ("get user: sql: no rows in result set").
+//
+// Types, context and trace are not included, they are sent by other attributes
+func message(custom *errorx.Error) string {
+	parts := make([]string, 0, 2)
+	seen := make(map[*errorx.Error]struct{})
+
+	var inner error = custom
+	for inner != nil {
+		current, ok := inner.(*errorx.Error)
+		if !ok {
+			parts = append(parts, inner.Error())
+			break
+		}
+
+		if _, exist := seen[current]; exist {
+			break
+		}
+		seen[current] = struct{}{}
+
+		if text := current.Message(); text != "" {
+			parts = append(parts, text)
+		}
+
+		inner = current.InnerError()
+	}
+
+	return strings.Join(parts, ": ")
+}
+
+// kindOf returns class of the error: type chain of custom error or Go type of built-in one
+func kindOf(err error) string {
+	if custom, ok := errorx.TryGet(err); ok && custom.Type() != "" {
+		return custom.Type()
+	}
+
+	return fmt.Sprintf("%T", err)
+}
+
+// decimalID converts hex ID (128-bit trace ID or 64-bit span ID) to decimal format by its lower 64 bits.
+//
+// OpenTelemetry IDs have fixed length (32 hex digits for trace ID, 16 for span ID), so IDs of such length
+// are treated as hex even if they consist of digits only. Other IDs are returned as is if they are decimal
+func decimalID(id string) string {
+	hex := len(id) == 32 || len(id) == 16
+	if !hex {
+		if _, err := strconv.ParseUint(id, 10, 64); err == nil {
+			return id
+		}
+	}
+
+	if len(id) > 16 {
+		id = id[len(id)-16:]
+	}
+
+	value, err := strconv.ParseUint(id, 16, 64)
+	if err != nil {
+		return id
+	}
+
+	return strconv.FormatUint(value, 10)
+}
@@ -0,0 +1,55 @@
+package datadogx
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/boostgo/errorx"
+)
+
+func TestFieldsMessage(t *testing.T) {
+	wrapped := error(sql.ErrNoRows)
+	errorx.Wrap("Repo", &wrapped, "select user")
+	errorx.Wrap("Usecase", &wrapped, "get user")
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "built-in error",
+			err:  errors.New("timeout"),
+			want: "timeout",
+		},
+		{
+			name: "custom error without inner error",
+			err:  errorx.New("get user").SetType("Usecase"),
+			want: "get user",
+		},
+		{
+			name: "wrapped built-in error",
+			err:  wrapped,
+			want: "get user - select user: " + sql.ErrNoRows.Error(),
+		},
+		{
+			name: "inner custom error",
+			err:  errorx.New("get user").SetError(errorx.New("select user").SetError(sql.ErrNoRows)),
+			want: "get user: select user: " + sql.ErrNoRows.Error(),
+		},
+		{
+			name: "empty message",
+			err:  errorx.New("").SetError(sql.ErrNoRows),
+			want: sql.ErrNoRows.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Fields(tt.err)[KeyErrorMessage]; got != tt.want {
+				t.Errorf("error.message = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package errorx
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	OffsetKey = "offset"
+
+	CodeInvalidJSON  = "invalid_json"
+	CodeInvalidType  = "invalid_type"
+	CodeUnknownField = "unknown_field"
+)
+
+// TranslateJSON converts JSON decoding error to validation error (see Validation), so API could return actionable 400 response:
+//
+//   - *json.UnmarshalTypeError - field error of the offending field with CodeInvalidType code and expected JSON type
+//   - *json.SyntaxError, unexpected EOF - field error with CodeInvalidJSON code
+//   - "json: unknown field" (Decoder.DisallowUnknownFields) - field error of unknown field with CodeUnknownField code
+//
+// Byte offset of the problem is written to the message and stored in the context by OffsetKey.
+// Other errors (like *json.InvalidUnmarshalError, which is programmer's mistake) are returned as is.
+// If provided error is nil - return nil
+func TranslateJSON(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		typeErr   *json.UnmarshalTypeError
+		syntaxErr *json.SyntaxError
+		field     FieldError
+		offset    int64 = -1
+	)
+
+	switch {
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+		field = FieldError{
+			Field:   jsonFieldPath(typeErr.Field),
+			Code:    CodeInvalidType,
+			Message: "must be " + jsonTypeName(typeErr.Type) + ", got " + typeErr.Value + " at offset " + strconv.FormatInt(offset, 10),
+		}
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+		field = FieldError{
+			Code:    CodeInvalidJSON,
+			Message: syntaxErr.Error() + " at offset " + strconv.FormatInt(offset, 10),
+		}
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		field = FieldError{
+			Code:    CodeInvalidJSON,
+			Message: "unexpected end of JSON input",
+		}
+	case errors.Is(err, io.EOF):
+		field = FieldError{
+			Code:    CodeInvalidJSON,
+			Message: "empty JSON input",
+		}
+	default:
+		name, ok := strings.CutPrefix(err.Error(), "json: unknown field ")
+		if !ok {
+			return err
+		}
+
+		if unquoted, e := strconv.Unquote(name); e == nil {
+			name = unquoted
+		}
+
+		field = FieldError{
+			Field:   name,
+			Code:    CodeUnknownField,
+			Message: "unknown field",
+		}
+	}
+
+	translated := Get(Validation(field))
+	if offset >= 0 {
+		translated = translated.AddContext(OffsetKey, offset)
+	}
+
+	return translated
+}
+
+// jsonTypeName returns name of JSON type which Go type is decoded from
+func jsonTypeName(typ reflect.Type) string {
+	if typ == nil {
+		return "value"
+	}
+
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return "non-negative integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	default:
+		return typ.String()
+	}
+}
+
+// jsonFieldPath converts field path of the decoder ("items.0.price") to bracketed notation ("items[0].price")
+func jsonFieldPath(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	segments := make([]any, 0, strings.Count(path, ".")+1)
+	for _, segment := range strings.Split(path, ".") {
+		if index, err := strconv.Atoi(segment); err == nil {
+			segments = append(segments, index)
+			continue
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return FieldPath(segments...)
+}
@@ -0,0 +1,45 @@
+package errorx
+
+const (
+	OccurrencesKey = "occurrences"
+)
+
+// Dedupe collapses identical (by Fingerprint) sub-errors of MultiError or joined error into one.
+//
+// Collapsed errors are annotated by OccurrencesKey context with count of occurrences.
+// Order of errors is kept by first occurrence. If provided error is not aggregate - it is returned as is
+func Dedupe(err error) error {
+	errs := expandAggregates(err)
+	if len(errs) < 2 {
+		return err
+	}
+
+	order := make([]string, 0, len(errs))
+	first := make(map[string]error, len(errs))
+	counts := make(map[string]int, len(errs))
+	for _, e := range errs {
+		fingerprint := Fingerprint(e)
+		if _, exist := first[fingerprint]; !exist {
+			order = append(order, fingerprint)
+			first[fingerprint] = e
+		}
+		counts[fingerprint]++
+	}
+
+	var deduped *MultiError
+	for _, fingerprint := range order {
+		e := first[fingerprint]
+		if counts[fingerprint] > 1 {
+			e = withOccurrences(e, counts[fingerprint])
+		}
+
+		deduped = Append(deduped, e)
+	}
+
+	return deduped.ErrorOrNil()
+}
+
+// withOccurrences returns copy of the error annotated by count of occurrences
+func withOccurrences(err error, count int) error {
+	return detach(err).AddContext(OccurrencesKey, count)
+}
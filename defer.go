@@ -0,0 +1,24 @@
+package errorx
+
+// Defer wraps provided error (if it is not nil) with the name of the function which deferred it as the type.
+//
+// Removes boilerplate of passing type strings to Wrap:
+//
+//	func (r *UserRepository) GetByID(id int) (user User, err error) {
+//		defer errorx.Defer(&err, "get user by id")
+//		...
+//	}
+//
+// Error type will be like "repository.(*UserRepository).GetByID"
+func Defer(err *error, message ...string) {
+	if err == nil || *err == nil {
+		return
+	}
+
+	var wrapMessage string
+	if len(message) > 0 {
+		wrapMessage = message[0]
+	}
+
+	Wrap(callerFunction(1), err, wrapMessage)
+}
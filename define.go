@@ -0,0 +1,27 @@
+package errorx
+
+import "sync/atomic"
+
+var lastID atomic.Uint64
+
+// Define creates sentinel error with unique identity.
+//
+// Errors created from defined error by Copy keep its identity, so they are matched by Is
+// even if their messages, types or context were changed. Errors with the same text but created separately
+// are not matched:
+//
+//	var ErrUserNotFound = errorx.Define("user not found").SetCode("USER_NOT_FOUND")
+//
+//	return ErrUserNotFound.Copy(sql.ErrNoRows)
+//	...
+//	errors.Is(err, ErrUserNotFound) // true
+func Define(message string) *Error {
+	defined := newMessage(message)
+	defined.id = lastID.Add(1)
+	return defined
+}
+
+// IsDefined returns true if error was created by Define (or copied from defined error)
+func (err *Error) IsDefined() bool {
+	return err.id != 0
+}
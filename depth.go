@@ -0,0 +1,63 @@
+package errorx
+
+import (
+	"slices"
+	"sync/atomic"
+)
+
+// maxDepth is max count of wrap levels of one error. 0 means unlimited
+var maxDepth atomic.Int64
+
+// SetMaxDepth sets max count of wrap levels of one error. Default is 0 (unlimited), min limit is 2.
+//
+// When error reaches max depth, Wrap collapses middle levels: the first (inner) level where error was created
+// and the newest levels are kept, the oldest wrap level is dropped and its context is moved to the next level.
+// So repeated wrapping in retry loops does not grow error unbounded
+func SetMaxDepth(depth int) {
+	if depth > 0 {
+		depth = max(depth, 2)
+	}
+
+	maxDepth.Store(int64(max(depth, 0)))
+}
+
+// MaxDepth returns max count of wrap levels of one error (see SetMaxDepth)
+func MaxDepth() int {
+	return int(maxDepth.Load())
+}
+
+// Depth returns count of wrap levels of the deepest path of the error chain (see Walk).
+//
+// Every Wrap adds one level, built-in errors are levels too. If provided error is nil - return 0
+func Depth(err error) int {
+	depth := 0
+	Walk(err, func(layer Layer) bool {
+		depth = max(depth, layer.Depth+1)
+		return true
+	})
+
+	return depth
+}
+
+// collapse makes room for the new wrap level if error reached max depth (see SetMaxDepth):
+// the oldest wrap level (next to the first one) is dropped, its context is moved to the next level
+func (err *Error) collapse() *Error {
+	limit := maxDepth.Load()
+	count := err.layerCount()
+	if limit <= 0 || int64(count) < limit {
+		return err
+	}
+
+	const dropped = 1
+	err = err.derive()
+	err.levels = slices.Delete(slices.Clone(err.levels), dropped, dropped+1)
+
+	err.ownContext()
+	for i := range err.fields {
+		if err.fields[i].layer > dropped {
+			err.fields[i].layer--
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,112 @@
+package errorx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestDepth(t *testing.T) {
+	wrapped := func(err error, times int) error {
+		for i := range times {
+			Wrap(fmt.Sprintf("Layer %d", i), &err, fmt.Sprintf("call %d", i))
+		}
+
+		return err
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "nil error", err: nil, want: 0},
+		{name: "built-in error", err: errors.New("oops"), want: 1},
+		{name: "new error", err: New("oops"), want: 1},
+		{name: "wrapped built-in error", err: wrapped(errors.New("oops"), 2), want: 3},
+		{name: "wrapped custom error", err: wrapped(New("oops"), 3), want: 4},
+		{name: "deepest joined error", err: New("batch").SetError(errors.New("a"), wrapped(New("b"), 2)), want: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Depth(tt.err); got != tt.want {
+				t.Errorf("Depth() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxDepth int
+		wraps    int
+		messages []string
+		keys     []string
+	}{
+		{
+			name:     "unlimited",
+			maxDepth: 0,
+			wraps:    4,
+			messages: []string{"oops", "call 0", "call 1", "call 2", "call 3"},
+			keys:     []string{"created", "key 0", "key 1", "key 2", "key 3"},
+		},
+		{
+			name:     "limit is not reached",
+			maxDepth: 5,
+			wraps:    4,
+			messages: []string{"oops", "call 0", "call 1", "call 2", "call 3"},
+			keys:     []string{"created", "key 0", "key 1", "key 2", "key 3"},
+		},
+		{
+			name:     "oldest wrap levels are dropped",
+			maxDepth: 3,
+			wraps:    4,
+			messages: []string{"oops", "call 2", "call 3"},
+			keys:     []string{"created", "key 0", "key 1", "key 2", "key 3"},
+		},
+		{
+			name:     "min limit is 2",
+			maxDepth: 1,
+			wraps:    3,
+			messages: []string{"oops", "call 2"},
+			keys:     []string{"created", "key 0", "key 1", "key 2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetMaxDepth(tt.maxDepth)
+			defer SetMaxDepth(0)
+
+			err := error(New("oops").AddContext("created", true))
+			for i := range tt.wraps {
+				Wrap("Layer", &err, fmt.Sprintf("call %d", i), map[string]any{fmt.Sprintf("key %d", i): i})
+			}
+
+			layers := Get(err).Layers()
+			messages := make([]string, 0, len(layers))
+			keys := make([]string, 0)
+			for _, layer := range layers {
+				messages = append(messages, layer.Message)
+				keys = append(keys, layer.Keys...)
+			}
+			slices.Reverse(messages)
+			slices.Sort(keys)
+
+			if !slices.Equal(messages, tt.messages) {
+				t.Errorf("messages = %q, want %q", messages, tt.messages)
+			}
+
+			if !slices.Equal(keys, tt.keys) {
+				t.Errorf("context keys = %q, want %q", keys, tt.keys)
+			}
+
+			if got := Depth(err); got != len(tt.messages) {
+				t.Errorf("Depth() = %d, want %d", got, len(tt.messages))
+			}
+		})
+	}
+}
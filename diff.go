@@ -0,0 +1,125 @@
+package errorx
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/boostgo/convert"
+)
+
+// Diff returns human-readable difference of two error chains: codes, kinds and levels (types, messages and context keys).
+// Levels are compared by position from the outer level (see Walk).
+//
+// Useful for debugging "why didn't Is() match" situations and for test failure messages:
+//
+//	fmt.Println(errorx.Diff(got, want))
+//	// kind: "not_found" != "invalid"
+//	// level 0: message "get user" != "get users"
+//	// level 0: context "user_id": 1 != 2
+//	// level 1: only in b: [SQL] select
+//
+// If chains have no differences - return empty string
+func Diff(a, b error) string {
+	lines := make([]string, 0)
+	addf := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	switch {
+	case a == nil && b == nil:
+		return ""
+	case a == nil:
+		return "a is nil"
+	case b == nil:
+		return "b is nil"
+	}
+
+	if codeA, codeB := CodeOf(a), CodeOf(b); codeA != codeB {
+		addf("code: %q != %q", codeA, codeB)
+	}
+
+	if kindA, kindB := KindOf(a), KindOf(b); kindA != kindB {
+		addf("kind: %q != %q", kindA, kindB)
+	}
+
+	layersA, layersB := collectLayers(a), collectLayers(b)
+	for i := range max(len(layersA), len(layersB)) {
+		switch {
+		case i >= len(layersB):
+			addf("level %d: only in a: %s", i, describeLayer(layersA[i]))
+			continue
+		case i >= len(layersA):
+			addf("level %d: only in b: %s", i, describeLayer(layersB[i]))
+			continue
+		}
+
+		layerA, layerB := layersA[i], layersB[i]
+		if layerA.Type != layerB.Type {
+			addf("level %d: type %q != %q", i, layerA.Type, layerB.Type)
+		}
+
+		if layerA.Message != layerB.Message {
+			addf("level %d: message %q != %q", i, layerA.Message, layerB.Message)
+		}
+
+		for _, key := range contextKeys(layerA.Context, layerB.Context) {
+			valueA, okA := layerA.Context[key]
+			valueB, okB := layerB.Context[key]
+			switch {
+			case !okB:
+				addf("level %d: context %q only in a", i, key)
+			case !okA:
+				addf("level %d: context %q only in b", i, key)
+			case !reflect.DeepEqual(valueA, valueB):
+				textA, textB := convert.String(valueA), convert.String(valueB)
+				if textA == textB {
+					// the same text, but different types
+					textA, textB = fmt.Sprintf("%#v", valueA), fmt.Sprintf("%#v", valueB)
+				}
+
+				addf("level %d: context %q: %s != %s", i, key, textA, textB)
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// collectLayers returns all levels of the error chain (see Walk)
+func collectLayers(err error) []Layer {
+	layers := make([]Layer, 0)
+	Walk(err, func(layer Layer) bool {
+		layers = append(layers, layer)
+		return true
+	})
+
+	return layers
+}
+
+// contextKeys returns sorted keys of both contexts without duplicates
+func contextKeys(a, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+	return keys
+}
+
+// describeLayer returns level as string: "[type] message"
+func describeLayer(layer Layer) string {
+	if layer.Type == "" {
+		return layer.Message
+	}
+
+	return "[" + layer.Type + "] " + layer.Message
+}
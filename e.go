@@ -0,0 +1,49 @@
+package errorx
+
+// E builds error by types of provided arguments (in upspin style):
+//
+//   - string - message (every next string is the next message layer)
+//   - Kind - kind
+//   - Code - code
+//   - error - inner error (several errors are joined)
+//   - map[string]any, Group - context
+//   - Option - applies option (see NewWith)
+//
+// Arguments of other types are stored in context by "!BADKEY" key. Nil arguments are ignored.
+// If there are no arguments - return nil
+//
+//	return errorx.E("get user", errorx.KindNotFound, errorx.Code("USER_NOT_FOUND"), err)
+func E(args ...any) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	err := newMessage("")
+
+	inner := make([]error, 0)
+	for _, arg := range args {
+		switch value := arg.(type) {
+		case nil:
+		case string:
+			err.setMessage(value)
+		case Kind:
+			err = err.SetKind(value)
+		case Code:
+			err = err.SetCode(value)
+		case error:
+			inner = append(inner, value)
+		case map[string]any:
+			err = err.SetContext(value)
+		case Group:
+			err = err.SetContext(value)
+		case Option:
+			if value != nil {
+				err = value(err)
+			}
+		default:
+			err = err.AddContext(badKey, value)
+		}
+	}
+
+	return notifyCreate(err.SetError(inner...))
+}
@@ -3,16 +3,33 @@ package errorx
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"reflect"
 	"slices"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/boostgo/convert"
 )
 
 const (
 	DefaultType = ""
+
+	badKey = "!BADKEY"
 )
 
+// compactError excludes context and trace from Error() output
+var compactError atomic.Bool
+
+// SetCompactError enables or disables compact mode: Error() returns only types, messages and inner error,
+// context and trace are available by Details() method.
+//
+// Useful for loggers which print error inline and attach context as structured fields
+func SetCompactError(enabled bool) {
+	compactError.Store(enabled)
+}
+
 // Error is custom error which implements built-in error interface.
 //
 // Struct contains hierarchy of error messages and their types; context (map) and inner error.
@@ -20,23 +37,63 @@ const (
 //	For example, error types could be like "User Handler - User Usecase - User Repository - SQL"
 //	It means that first error created on "SQL" level (sql, sqlx or any other module), then error wrapped
 //	by "User Repository" level, then "User Usecase" level and so on.
+//
+// Error is immutable: methods like SetType, AddContext and so on return new error (copy-on-write)
+// and never change the current one, so errors (sentinels too) are safe to share across goroutines.
+// Always use returned value: err = err.AddContext("key", value)
 type Error struct {
-	message    []string
-	errorTypes []string
-	context    map[string]any
+	levels     []level
+	fields     []field
 	innerError error
+	suppressed []error
+	trace      []string
+	createdAt  time.Time
+	code       Code
+	kind       Kind
+	tags       []string
+	retry      retryState
+	retryAfter time.Duration
+
+	index       map[string]int
+	mergePolicy MergePolicy
+	separator   *string
+	order       Order
+	id          uint64
+	userMessage *userMessage
+
+	// sharedContext means context fields are shared with another error and must be copied before write
+	sharedContext bool
+	// cache keeps joined messages and types (see Message and Type)
+	cache *layerCache
+}
+
+// cachedError is error allocated together with its cache, so every error (and every copy made by setters)
+// costs one allocation instead of two
+type cachedError struct {
+	err   Error
+	cache layerCache
 }
 
-// New creates new Error object with provided message
+// New creates new Error object with provided message.
+//
+// Create hooks are called with created error (see OnCreate)
 func New(message string) *Error {
-	messages := make([]string, 0)
-	messages = append(messages, message)
+	return notifyCreate(newMessage(message))
+}
 
-	return &Error{
-		message:    messages,
-		errorTypes: make([]string, 0),
-		context:    make(map[string]any),
-	}
+// newMessage creates new Error object with provided message without calling create hooks
+func newMessage(message string) *Error {
+	err := newError()
+	err.levels = []level{{message: message}}
+	err.createdAt = time.Now()
+	return err
+}
+
+// newError returns empty error with cache
+func newError() *Error {
+	block := &cachedError{}
+	block.err.cache = &block.cache
+	return &block.err
 }
 
 // Copy copies provided err to the new one.
@@ -47,18 +104,31 @@ func New(message string) *Error {
 func Copy(err error, innerErrors ...error) error {
 	custom, ok := TryGet(err)
 	if !ok {
-		return New(err.Error()).
-			SetError(innerErrors...)
+		return notifyCreate(newMessage(err.Error()).
+			SetError(innerErrors...))
 	}
 
 	inner := make([]error, 0, len(innerErrors)+1)
 	inner = append(inner, custom.innerError)
 	inner = append(inner, innerErrors...)
 
-	return New(custom.Message()).
+	copied := newMessage(custom.Message()).
 		SetType(custom.Type()).
-		SetContext(custom.Context()).
+		SetCode(custom.code).
+		SetKind(custom.kind).
 		SetError(inner...)
+	copied.id = custom.id
+	copied.retry = custom.retry
+	copied.retryAfter = custom.retryAfter
+	copied.userMessage = custom.userMessage
+	copied.tags = slices.Clone(custom.tags)
+	copied.trace = slices.Clone(custom.trace)
+	for _, key := range custom.contextOrder() {
+		value, _ := custom.contextValue(key)
+		copied.setContextValue(key, value)
+	}
+
+	return notifyCreate(copied)
 }
 
 // Copy copies current error to the new one.
@@ -73,90 +143,132 @@ func (err *Error) Copy(innerErrors ...error) error {
 // Message returns all messages joined in one and reverse them.
 //
 // For example, there are messages: ["QueryxContext", "GetUser", "GetByID"]
-// it will be "QueryxContext - GetByID - GetUser"
+// it will be "GetByID - GetUser - QueryxContext".
+//
+// Separator and order could be changed by SetSeparator and SetOrder
 func (err *Error) Message(onlyFirst ...int) string {
-	reversed := make([]string, len(err.message))
-	copy(reversed, err.message)
-	slices.Reverse(reversed)
-
-	if len(onlyFirst) > 0 && onlyFirst[0] > 0 {
-		reversed = limitSlice(reversed, onlyFirst[0])
+	if len(onlyFirst) == 0 || onlyFirst[0] <= 0 {
+		return err.joinCached(layerMessage)
 	}
 
-	return strings.Join(reversed, " - ")
+	return err.joinLayers(err.messages(), onlyFirst...)
 }
 
-// SetType append new type in chain of errors
+// SetType append new type in chain of errors. Type strings are interned.
+//
+// If the outer level has no type yet (error is created by New) - type is set to this level,
+// otherwise new level with this type is added
 func (err *Error) SetType(errorType string) *Error {
-	err.errorTypes = append(err.errorTypes, errorType)
+	err = err.derive()
+	if outer := len(err.levels) - 1; outer >= 0 && err.levels[outer].errType == "" {
+		err.levels = slices.Clone(err.levels)
+		err.levels[outer].errType = internType(errorType)
+		return err
+	}
+
+	err.levels = append(err.levels, level{errType: internType(errorType)})
 	return err
 }
 
 // Type returns all types joined in one and reverse them.
 //
 // For example, there are types: ["SQL", "User Repository", "User Usecase"]
-// it will be "User Usecase - User Repository - SQL"
+// it will be "User Usecase - User Repository - SQL".
+//
+// Separator and order could be changed by SetSeparator and SetOrder
 func (err *Error) Type(onlyFirst ...int) string {
-	reversed := make([]string, len(err.errorTypes))
-	copy(reversed, err.errorTypes)
-	slices.Reverse(reversed)
-
-	if len(onlyFirst) > 0 && onlyFirst[0] > 0 {
-		reversed = limitSlice(reversed, onlyFirst[0])
+	if len(onlyFirst) == 0 || onlyFirst[0] <= 0 {
+		return err.joinCached(layerType)
 	}
 
-	return strings.Join(reversed, " - ")
+	return err.joinLayers(err.types(), onlyFirst...)
 }
 
-// Context returns current error context (map)
+// Context returns copy of current error context (map)
 func (err *Error) Context() map[string]any {
-	return err.context
+	return err.contextMap()
 }
 
-// SetContext append all key-value pairs to the current context map
+// SetContext returns new error with all key-value pairs appended to the context map.
+//
+// Current error is not changed, so method is safe to call concurrently on shared errors.
+//
+// Existing keys are merged by merge policy of the error (or global one). See SetMergePolicy
 func (err *Error) SetContext(context map[string]any) *Error {
 	if context == nil || len(context) == 0 {
 		return err
 	}
 
-	for key, value := range context {
-		err.context[key] = value
-	}
-
+	err = err.derive()
+	_ = err.mergeContext(context, err.policy())
 	return err
 }
 
-// AddContext append new key-value one pair to the current context map.
+// AddContext returns new error with key-value pair appended to the context map.
 //
-// But if provided key-value pair is array string as value and "trace" as key, it will be ignored
+// Current error is not changed, so method is safe to call concurrently on shared errors.
+//
+// Nil values are ignored. Deprecated "trace" key is not stored in context but set as trace of the error (see SetTrace)
 func (err *Error) AddContext(key string, value any) *Error {
 	if value == nil {
 		return err
 	}
 
-	if arr, ok := value.([]string); ok && key == "trace" {
-		if len(arr) == 0 {
-			return err
-		}
+	err = err.derive()
+	err.setContextValue(key, value)
+	return err
+}
+
+// With append key-value pairs to the current context map (slog style).
+//
+// Arguments are alternating keys and values: With("user_id", 1, "email", "john@doe.com").
+// Not string keys converts to string and value without key is stored by "!BADKEY" key
+func (err *Error) With(kv ...any) *Error {
+	if len(kv) == 0 {
+		return err
 	}
 
-	err.context[key] = value
+	err = err.derive()
+	for i := 0; i < len(kv); i += 2 {
+		if i+1 >= len(kv) {
+			err.setContextValue(badKey, kv[i])
+			break
+		}
+
+		key, ok := kv[i].(string)
+		if !ok {
+			key = convert.String(kv[i])
+		}
+
+		if kv[i+1] != nil {
+			err.setContextValue(key, kv[i+1])
+		}
+	}
 
 	return err
 }
 
-// RemoveContext removes value from context map by provided key
+// RemoveContext returns new error without context value by provided key.
+//
+// Current error is not changed, so method is safe to call concurrently on shared errors
 func (err *Error) RemoveContext(key string) *Error {
 	if key == "" {
 		return err
 	}
 
-	_, ok := err.context[key]
+	if key == traceKey {
+		err = err.derive()
+		err.trace = nil
+		return err
+	}
+
+	_, ok := err.contextValue(key)
 	if !ok {
 		return err
 	}
 
-	delete(err.context, key)
+	err = err.derive()
+	err.deleteContextValue(key)
 	return err
 }
 
@@ -173,6 +285,7 @@ func (err *Error) SetError(innerError ...error) *Error {
 		return err
 	}
 
+	err = err.derive()
 	var inner error
 	if len(innerError) == 1 {
 		inner = innerError[0]
@@ -183,8 +296,21 @@ func (err *Error) SetError(innerError ...error) *Error {
 	return err
 }
 
-// Error returns result of String() method
+// Error returns result of String() method.
+//
+// If format template is set (see SetFormatTemplate), error is rendered by template.
+// If compact mode is enabled (see SetCompactError), context and trace are excluded (see Details)
 func (err *Error) Error() string {
+	if formatted, ok := err.executeTemplate(); ok {
+		return formatted
+	}
+
+	if compactError.Load() {
+		builder := strings.Builder{}
+		err.writeSummary(&builder)
+		return truncate(builder.String())
+	}
+
 	return err.String()
 }
 
@@ -192,100 +318,219 @@ func (err *Error) Error() string {
 //
 // Method uses string builder and it's grow method.
 //
-// Method prints: types, messages and context
+// Method prints: types, messages, inner error, context and trace.
+// If inner error is join/multi error, every sub-error is printed on its own numbered line.
+//
+// Output is limited by max length (see SetMaxLength)
 func (err *Error) String() string {
 	builder := strings.Builder{}
+	err.writeSummary(&builder)
 
-	if len(err.errorTypes) > 0 {
-		_, _ = fmt.Fprintf(&builder, "[%s] ", err.Type())
+	if details := err.Details(); details != "" {
+		builder.WriteString(". ")
+		builder.WriteString(details)
 	}
 
-	builder.WriteString(err.Message())
+	return truncate(builder.String())
+}
 
-	if err.innerError != nil {
-		innerMessage := err.innerError.Error()
-		_, _ = fmt.Fprintf(&builder, ": %s", innerMessage)
-	}
-
-	if err.context != nil && len(err.context) > 0 {
-		builder.WriteString(". Context: ")
-		for key, value := range err.context {
-			if key == "trace" {
-				trace, ok := value.([]string)
-				if !ok {
-					builder.WriteString("\n\t")
-					builder.WriteString(value.(string))
-					continue
-				}
-
-				if len(trace) > 0 {
-					for _, line := range trace {
-						builder.WriteString("\n\t")
-						builder.WriteString(line)
-					}
-				}
-				continue
-			}
+// Details returns context and trace of the error (the part of String() after types, messages and inner error)
+func (err *Error) Details() string {
+	builder := strings.Builder{}
+
+	if err.contextLen() > 0 {
+		builder.WriteString("Context: ")
+		context := redactContext(err.contextMap())
+		for _, key := range err.contextOrder() {
+			_, _ = fmt.Fprintf(&builder, "%s=%s;", key, contextValueString(context[key]))
+		}
+	}
+
+	if trace := err.renderedTrace(); len(trace) > 0 {
+		if builder.Len() > 0 {
+			builder.WriteString(". ")
+		}
 
-			_, _ = fmt.Fprintf(&builder, "%s=%s;", key, convert.String(value))
+		builder.WriteString("Trace:")
+		for _, line := range trace {
+			builder.WriteString("\n\t")
+			builder.WriteString(line)
 		}
 	}
 
 	return builder.String()
 }
 
+// writeSummary writes types, messages and inner error
+func (err *Error) writeSummary(builder *strings.Builder) {
+	if err.hasType() {
+		_, _ = fmt.Fprintf(builder, "[%s] ", err.Type())
+	}
+
+	message := err.Message()
+	builder.WriteString(message)
+
+	if err.innerError != nil {
+		if message != "" {
+			builder.WriteString(":")
+			if !isMultiError(err.innerError) {
+				builder.WriteString(" ")
+			}
+		}
+
+		if err.cyclic() {
+			builder.WriteString(cycleMessage)
+			return
+		}
+
+		writeInnerError(builder, err.innerError)
+	}
+}
+
 // Is compares current error with provided target error.
 //
-// By comparing errors method check if provided error is custom or not:
+// Method compares only current error (without inner errors) if target is custom error
+// by equals method (identity of defined errors, codes or strings).
 //
-//	if custom - use equals method.
-//	If not custom - unwrap current error and compare unwrapped inner errors with provided target
+// Inner errors are traversed by errors.Is through Unwrap method, so built-in errors buried
+// several layers down are found too
 func (err *Error) Is(target error) bool {
-	custom, ok := TryGet(target)
+	custom, ok := target.(*Error)
 	if !ok {
-		if innerErrs := err.Unwrap(); innerErrs != nil && len(innerErrs) > 0 {
-			for _, inner := range innerErrs {
-				if errors.Is(inner, target) {
-					return true
-				}
-			}
-		}
-
 		return false
 	}
 
 	return equals(err, custom)
 }
 
-// Unwrap takes inner error and try to take inside wrapped errors.
+// Unwrap returns inner error as slice (stdlib multi-unwrap convention).
 //
-// Method works only for custom errors, otherwise to result error slice will be added just inner error by itself
+// Method returns only direct inner error, deeper errors are traversed by errors.Is/errors.As themselves.
+// If error is wrapped by its own inner error (cycle) - return nil, so errors.Is does not loop forever
 func (err *Error) Unwrap() []error {
-	if err.innerError == nil {
-		return []error{}
+	if err.innerError == nil || err.selfWrapped() {
+		return nil
 	}
 
-	unwrapped := make([]error, 0)
-	unwrapped = append(unwrapped, err.innerError)
-	custom, ok := TryGet(err.innerError)
-	if ok {
-		unwrapped = append(unwrapped, custom.Unwrap()...)
-	}
+	return []error{err.innerError}
+}
+
+// clone creates shallow copy of the error with own messages, types and context map
+func (err *Error) clone() *Error {
+	cloned := *err
+	cloned.levels = slices.Clone(err.levels)
+	cloned.fields = slices.Clone(err.fields)
+	cloned.index = maps.Clone(err.index)
+	cloned.sharedContext = false
+	cloned.tags = slices.Clone(err.tags)
+	cloned.trace = slices.Clone(err.trace)
+	cloned.suppressed = slices.Clone(err.suppressed)
+	cloned.cache = new(layerCache)
+	return &cloned
+}
 
-	return unwrapped
+// derive returns shallow copy of the error for copy-on-write mutation.
+//
+// Slices are shared but clipped, so appending to them allocates new arrays.
+// Context fields are shared and copied on the first write (see ownContext)
+func (err *Error) derive() *Error {
+	derived := newError()
+	cache := derived.cache
+	*derived = *err
+	derived.cache = cache
+	derived.levels = slices.Clip(err.levels)
+	derived.fields = slices.Clip(err.fields)
+	derived.tags = slices.Clip(err.tags)
+	derived.trace = slices.Clip(err.trace)
+	derived.suppressed = slices.Clip(err.suppressed)
+	derived.sharedContext = true
+	return derived
 }
 
-// setMessage appends new message to the chain
+// setMessage sets message of the outer level if it has no message yet, otherwise adds new level with the message.
+// Empty message is ignored. Method changes current error, so it must be called only for just created errors
 func (err *Error) setMessage(message string) *Error {
-	err.message = append(err.message, message)
+	if message == "" {
+		return err
+	}
+
+	if outer := len(err.levels) - 1; outer >= 0 && err.levels[outer].message == "" {
+		err.levels = slices.Clone(err.levels)
+		err.levels[outer].message = message
+		return err
+	}
+
+	err.levels = append(err.levels, level{message: message})
 	return err
 }
 
-// equals compare two provided custom errors by fields like:
-// "type" and "error string"
+// wrapLevel returns new error with new wrap level. Level is added even if type and message are empty,
+// so context added by this wrap belongs to its own level
+func (err *Error) wrapLevel(errType, message string) *Error {
+	err = err.derive()
+	err.levels = append(err.levels, level{errType: internType(errType), message: message})
+	return err
+}
+
+// equals compare two provided custom errors.
+//
+// If any of errors is defined (see Define) - compares identity.
+// If both errors have codes - compares codes.
+// Otherwise compares types, messages, context, trace and inner errors directly (without building strings)
 func equals(err, target *Error) bool {
-	return err.Type() == target.Type() &&
-		err.Error() == target.Error()
+	if err == target {
+		return true
+	}
+
+	if err.id != 0 || target.id != 0 {
+		return err.id == target.id
+	}
+
+	if err.code != "" && target.code != "" {
+		return err.code == target.code
+	}
+
+	return slices.Equal(err.levels, target.levels) &&
+		slices.Equal(err.trace, target.trace) &&
+		equalContext(err, target) &&
+		equalInner(err.innerError, target.innerError)
+}
+
+// equalContext compares context of provided errors regardless of insertion order
+func equalContext(err, target *Error) bool {
+	if len(err.fields) != len(target.fields) {
+		return false
+	}
+
+	for _, f := range err.fields {
+		value, ok := target.contextValue(f.key)
+		if !ok || !reflect.DeepEqual(f.value, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalInner compares inner errors: custom errors by equals, joined errors one by one, built-in errors by text
+func equalInner(err, target error) bool {
+	if err == nil || target == nil {
+		return err == target
+	}
+
+	errCustom, errIsCustom := err.(*Error)
+	targetCustom, targetIsCustom := target.(*Error)
+	if errIsCustom || targetIsCustom {
+		return errIsCustom && targetIsCustom && equals(errCustom, targetCustom)
+	}
+
+	errMulti, errIsMulti := err.(interface{ Unwrap() []error })
+	targetMulti, targetIsMulti := target.(interface{ Unwrap() []error })
+	if errIsMulti && targetIsMulti {
+		return slices.EqualFunc(errMulti.Unwrap(), targetMulti.Unwrap(), equalInner)
+	}
+
+	return err == target || err.Error() == target.Error()
 }
 
 // TryGet convert provided error to the custom and say it is custom or not
@@ -317,7 +562,8 @@ func IsType(err error, errorType string) bool {
 
 // Is compare provided errors.
 //
-// Event if provided errors is not custom, comparing becomes by built-in "Is" function
+// Event if provided errors is not custom, comparing becomes by built-in "Is" function.
+// Errors of pkg/errors convention (Cause() error) are traversed too
 func Is(err, target error) bool {
 	if err == nil || target == nil {
 		return false
@@ -326,24 +572,29 @@ func Is(err, target error) bool {
 	// if provided error is not custom, then compare by built in "Is" function
 	errCustom, isCustom := TryGet(err)
 	if !isCustom {
-		return errors.Is(err, target)
+		return errors.Is(err, target) || causeIs(err, target)
 	}
 
 	// if provided target error is not custom, then compare by built in "Is" function
 	targetCustom, isCustom := TryGet(target)
 	if !isCustom {
-		return errors.Is(err, target)
+		return errors.Is(err, target) || causeIs(err, target)
 	}
 
-	// if both errors are custom, compare by custom "Is" function
-	return errCustom.Is(targetCustom)
+	// if both errors are custom, search target in the whole chain by custom "Is" function
+	return errors.Is(errCustom, targetCustom) || causeIs(err, targetCustom)
 }
 
 // Wrap convert provided error to custom with the provided error type and message.
 //
 // If provided error is built-in (default), then it will be converted to custom.
 //
-// If it is already custom, just take custom and set to it one more type & message
+// If it is already custom, just take custom and set to it one more type & message.
+// If error reached max depth, middle levels are collapsed (see SetMaxDepth).
+//
+// Standard library errors in the chain are classified by kind if kind is not set yet: sql.ErrNoRows - KindNotFound,
+// sql.ErrTxDone - KindInternal, context.Canceled - KindCanceled, context.DeadlineExceeded - KindTimeout.
+// Other well-known errors (fs, io) are classified only explicitly (see Promote, TranslateFS)
 func Wrap(errType string, err *error, message string, ctx ...map[string]any) {
 	if *err != nil {
 		var applyContext map[string]any
@@ -353,19 +604,31 @@ func Wrap(errType string, err *error, message string, ctx ...map[string]any) {
 
 		custom, ok := TryGet(*err)
 		if !ok {
-			*err = New(message).
+			custom = notifyCreate(classify(newMessage(message).
 				SetType(errType).
 				SetError(*err).
-				SetContext(applyContext)
+				SetContext(applyContext)))
 		} else {
-			*err = custom.
-				SetType(errType).
-				setMessage(message).
-				SetContext(applyContext)
+			custom = classify(custom.
+				collapse().
+				wrapLevel(errType, message).
+				SetContext(applyContext))
 		}
+
+		publish(EventWrap, custom)
+		*err = custom
 	}
 }
 
+// Wrapped works like Wrap, but returns wrapped error instead of mutating it by pointer.
+//
+// Useful for one-liners: return errorx.Wrapped("User Repository", err, "get user").
+// If provided error is nil - return nil
+func Wrapped(errType string, err error, message string, ctx ...map[string]any) error {
+	Wrap(errType, &err, message, ctx...)
+	return err
+}
+
 // Type returns type of custom error.
 //
 // If provided error is built-in - return DefaultType
@@ -0,0 +1,66 @@
+package errorx
+
+import (
+	"errors"
+	"testing"
+)
+
+func benchmarkErrors() map[string][2]*Error {
+	defined := Define("user not found")
+
+	return map[string][2]*Error{
+		"identity": {
+			Get(defined.Copy(errors.New("no rows"))),
+			defined,
+		},
+		"code": {
+			New("user not found").SetCode("USER_NOT_FOUND").SetContext(map[string]any{"id": 1}),
+			New("user not found").SetCode("USER_NOT_FOUND"),
+		},
+		"structural": {
+			New("get user").SetType("Repo").SetContext(map[string]any{"id": 1, "table": "users"}),
+			New("get user").SetType("Repo").SetContext(map[string]any{"id": 1, "table": "users"}),
+		},
+	}
+}
+
+func BenchmarkIs(b *testing.B) {
+	for name, pair := range benchmarkErrors() {
+		b.Run(name, func(b *testing.B) {
+			err, target := error(pair[0]), error(pair[1])
+			if !errors.Is(err, target) {
+				b.Fatalf("errors.Is(%v, %v) = false", err, target)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = errors.Is(err, target)
+			}
+		})
+	}
+}
+
+func BenchmarkEquals(b *testing.B) {
+	for name, pair := range benchmarkErrors() {
+		b.Run(name, func(b *testing.B) {
+			err, target := pair[0], pair[1]
+			if !equals(err, target) {
+				b.Fatalf("equals(%v, %v) = false", err, target)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = equals(err, target)
+			}
+		})
+	}
+}
+
+func BenchmarkSetters(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = New("get user").SetType("Repo").AddContext("user_id", i).SetKind(KindNotFound)
+	}
+}
@@ -0,0 +1,92 @@
+package errtest
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/boostgo/errorx"
+)
+
+// T is part of testing.TB which is used by assertions
+type T interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// AssertIs checks that target is in the chain of the error (by errorx.Is)
+func AssertIs(t T, err, target error) bool {
+	t.Helper()
+
+	if errorx.Is(err, target) {
+		return true
+	}
+
+	t.Errorf("error chain does not contain target\nwant: %s\ngot:\n%s", describe(target), chain(err))
+	return false
+}
+
+// AssertType checks that type chain of the error matches provided pattern (see errorx.HasType)
+func AssertType(t T, err error, pattern string) bool {
+	t.Helper()
+
+	if errorx.HasType(err, pattern) {
+		return true
+	}
+
+	t.Errorf("error type does not match\nwant: %q\ngot:  %q\nchain:\n%s", pattern, errorx.Type(err), chain(err))
+	return false
+}
+
+// AssertKind checks kind of the error (see errorx.KindOf)
+func AssertKind(t T, err error, kind errorx.Kind) bool {
+	t.Helper()
+
+	if got := errorx.KindOf(err); got != kind {
+		t.Errorf("error kind does not match\nwant: %q\ngot:  %q\nchain:\n%s", kind, got, chain(err))
+		return false
+	}
+
+	return true
+}
+
+// AssertContext checks context value of the error chain by provided key (see errorx.ContextValue)
+func AssertContext(t T, err error, key string, want any) bool {
+	t.Helper()
+
+	got, ok := errorx.ContextValue(err, key)
+	if !ok {
+		t.Errorf("error context has no key %q\nchain:\n%s", key, chain(err))
+		return false
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("error context value by key %q does not match\nwant: %#v\ngot:  %#v\nchain:\n%s", key, want, got, chain(err))
+		return false
+	}
+
+	return true
+}
+
+// chain returns readable tree of the error chain
+func chain(err error) string {
+	if err == nil {
+		return "\t<nil>"
+	}
+
+	var custom *errorx.Error
+	if !errors.As(err, &custom) {
+		return "\t" + err.Error()
+	}
+
+	return "\t" + strings.ReplaceAll(custom.Verbose(), "\n", "\n\t")
+}
+
+// describe returns one-line description of the error
+func describe(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+
+	return err.Error()
+}
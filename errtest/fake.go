@@ -0,0 +1,121 @@
+package errtest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/boostgo/errorx"
+)
+
+var (
+	fakeTypes    = []string{"Handler", "Usecase", "Repository", "SQL", "Cache", "Client", "Queue", "Storage"}
+	fakeMessages = []string{"get user", "create order", "query", "decode body", "send message", "read file", "update balance", "connect"}
+	fakeKeys     = []string{"user_id", "order_id", "query", "attempt", "path", "amount", "enabled", "region", "tenant", "limit"}
+	fakeCauses   = []string{"no rows in result set", "connection refused", "unexpected EOF", "deadline exceeded", "permission denied"}
+	fakeKinds    = []errorx.Kind{errorx.KindUnknown, errorx.KindNotFound, errorx.KindInvalid, errorx.KindTimeout, errorx.KindInternal}
+)
+
+// FakeOption sets up error generated by Fake
+type FakeOption func(options *fakeOptions)
+
+type fakeOptions struct {
+	depth       int
+	contextSize int
+	joins       int
+}
+
+// WithDepth sets count of wrap layers of generated error. Default is 3
+func WithDepth(depth int) FakeOption {
+	return func(options *fakeOptions) {
+		options.depth = max(depth, 1)
+	}
+}
+
+// WithContextSize sets count of context keys of every layer. Default is 2
+func WithContextSize(size int) FakeOption {
+	return func(options *fakeOptions) {
+		options.contextSize = max(size, 0)
+	}
+}
+
+// WithJoins sets count of joined errors at the root of the chain. Default is 0 (one built-in cause)
+func WithJoins(joins int) FakeOption {
+	return func(options *fakeOptions) {
+		options.joins = max(joins, 0)
+	}
+}
+
+// Fake generates reproducible error by provided seed: the same seed and options produce the same chain
+// (types, messages, kinds, context and causes). Only creation time differs, compare by errorx.IgnoreTimestamps.
+//
+// Useful for fuzzing serializers and benchmarking formatters:
+//
+//	err := errtest.Fake(42, errtest.WithDepth(10), errtest.WithContextSize(5), errtest.WithJoins(3))
+func Fake(seed int, opts ...FakeOption) *errorx.Error {
+	options := fakeOptions{
+		depth:       3,
+		contextSize: 2,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	random := rand.New(rand.NewPCG(uint64(seed), uint64(seed)^0x9e3779b97f4a7c15))
+
+	var cause error
+	if options.joins > 0 {
+		causes := make([]error, 0, options.joins)
+		for range options.joins {
+			causes = append(causes, fakeLayer(random, options.contextSize, errors.New(pick(random, fakeCauses))))
+		}
+		cause = errorx.Join(causes...)
+	} else {
+		cause = errors.New(pick(random, fakeCauses))
+	}
+
+	err := fakeLayer(random, options.contextSize, cause)
+	for i := 1; i < options.depth; i++ {
+		err = fakeLayer(random, options.contextSize, err)
+	}
+
+	return err
+}
+
+// fakeLayer generates one layer of the chain which wraps provided error
+func fakeLayer(random *rand.Rand, contextSize int, inner error) *errorx.Error {
+	err := errorx.New(pick(random, fakeMessages)).
+		SetType(pick(random, fakeTypes)).
+		SetKind(pick(random, fakeKinds))
+
+	for i := 0; i < contextSize; i++ {
+		key := fakeKeys[i%len(fakeKeys)]
+		if i >= len(fakeKeys) {
+			key = fmt.Sprintf("%s_%d", key, i/len(fakeKeys))
+		}
+
+		err = err.AddContext(key, fakeValue(random))
+	}
+
+	return err.SetError(inner)
+}
+
+// fakeValue generates context value of random type
+func fakeValue(random *rand.Rand) any {
+	switch random.IntN(4) {
+	case 0:
+		return random.IntN(10_000)
+	case 1:
+		return pick(random, fakeMessages)
+	case 2:
+		return random.IntN(2) == 1
+	default:
+		return float64(random.IntN(100_000)) / 100
+	}
+}
+
+func pick[T any](random *rand.Rand, values []T) T {
+	return values[random.IntN(len(values))]
+}
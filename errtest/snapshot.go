@@ -0,0 +1,198 @@
+package errtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/boostgo/errorx"
+)
+
+const (
+	// UpdateEnv is environment variable which enables rewriting of golden files when set to "1" or "true"
+	UpdateEnv = "ERRTEST_UPDATE"
+)
+
+// SnapshotOption sets up Snapshot
+type SnapshotOption func(options *snapshotOptions)
+
+type snapshotOptions struct {
+	update bool
+}
+
+// Update rewrites golden file instead of comparing with it if provided flag is true.
+// Usually it is bound to the "-update" flag of the test package:
+//
+//	var update = flag.Bool("update", false, "update golden files")
+//
+//	errtest.Snapshot(t, err, "testdata/err.golden", errtest.Update(*update))
+func Update(update bool) SnapshotOption {
+	return func(options *snapshotOptions) {
+		options.update = update
+	}
+}
+
+// snapshot is deterministic representation of the error: without timestamps, with normalized stack and sorted context
+type snapshot struct {
+	Type       string         `json:"type,omitempty"`
+	Message    string         `json:"message,omitempty"`
+	Code       string         `json:"code,omitempty"`
+	Kind       string         `json:"kind,omitempty"`
+	Tags       []string       `json:"tags,omitempty"`
+	Context    map[string]any `json:"context,omitempty"`
+	Stack      []string       `json:"stack,omitempty"`
+	Inner      any            `json:"inner,omitempty"`
+	Suppressed []any          `json:"suppressed,omitempty"`
+}
+
+// Snapshot serializes error deterministically and compares it with golden file.
+//
+// Timestamps are dropped, stack frames are normalized to "function (file.go)" without paths and line numbers,
+// context is sorted. Golden files are rewritten if Update option is true or UpdateEnv environment variable is set:
+//
+//	ERRTEST_UPDATE=1 go test ./...
+func Snapshot(t T, err error, golden string, opts ...SnapshotOption) bool {
+	t.Helper()
+
+	options := snapshotOptions{
+		update: updateEnabled(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	got, marshalErr := Serialize(err)
+	if marshalErr != nil {
+		t.Errorf("serialize error: %v", marshalErr)
+		return false
+	}
+
+	if options.update {
+		if mkdirErr := os.MkdirAll(filepath.Dir(golden), 0o755); mkdirErr != nil {
+			t.Errorf("create golden file directory: %v", mkdirErr)
+			return false
+		}
+
+		if writeErr := os.WriteFile(golden, got, 0o644); writeErr != nil {
+			t.Errorf("write golden file: %v", writeErr)
+			return false
+		}
+
+		return true
+	}
+
+	want, readErr := os.ReadFile(golden)
+	if readErr != nil {
+		t.Errorf("read golden file (run with %s=1 to create it): %v", UpdateEnv, readErr)
+		return false
+	}
+
+	if !bytes.Equal(want, got) {
+		t.Errorf("error snapshot does not match golden file %s:\n%s", golden, diff(string(want), string(got)))
+		return false
+	}
+
+	return true
+}
+
+// Serialize returns deterministic JSON representation of the error which is used by Snapshot
+func Serialize(err error) ([]byte, error) {
+	data, marshalErr := json.MarshalIndent(newSnapshot(err), "", "  ")
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+
+	return append(data, '\n'), nil
+}
+
+func newSnapshot(err error) any {
+	if err == nil {
+		return nil
+	}
+
+	custom, ok := err.(*errorx.Error)
+	if !ok {
+		if multi, isMulti := err.(interface{ Unwrap() []error }); isMulti {
+			inner := make([]any, 0)
+			for _, e := range multi.Unwrap() {
+				inner = append(inner, newSnapshot(e))
+			}
+
+			return inner
+		}
+
+		return err.Error()
+	}
+
+	view := snapshot{
+		Type:    custom.Type(),
+		Message: custom.Message(),
+		Code:    custom.Code().String(),
+		Kind:    custom.Kind().String(),
+		Tags:    custom.Tags(),
+		Context: custom.Redacted().Context(),
+		Inner:   newSnapshot(custom.InnerError()),
+	}
+
+	for _, frame := range custom.Frames() {
+		view.Stack = append(view.Stack, frame.Function+" ("+filepath.Base(frame.File)+")")
+	}
+
+	for _, suppressed := range custom.Suppressed() {
+		view.Suppressed = append(view.Suppressed, newSnapshot(suppressed))
+	}
+
+	return view
+}
+
+// updateEnabled checks if rewriting of golden files is enabled by UpdateEnv environment variable
+func updateEnabled() bool {
+	update, _ := strconv.ParseBool(os.Getenv(UpdateEnv))
+	return update
+}
+
+// diff returns line by line difference of want and got texts (by longest common subsequence)
+func diff(want, got string) string {
+	a := strings.Split(want, "\n")
+	b := strings.Split(got, "\n")
+
+	// lcs[i][j] is length of common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	builder := strings.Builder{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			_, _ = fmt.Fprintf(&builder, "  %s\n", a[i])
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			_, _ = fmt.Fprintf(&builder, "+ %s\n", b[j])
+			j++
+		default:
+			_, _ = fmt.Fprintf(&builder, "- %s\n", a[i])
+			i++
+		}
+	}
+
+	return builder.String()
+}
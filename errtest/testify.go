@@ -0,0 +1,163 @@
+package errtest
+
+import (
+	"fmt"
+
+	"github.com/boostgo/errorx"
+)
+
+// TestingT is interface of testify (assert.TestingT) and testing.TB
+type TestingT interface {
+	Errorf(format string, args ...any)
+}
+
+// ErrorAssertionFunc has the same signature as testify assert.ErrorAssertionFunc, so adapters could be used
+// in table tests which check errors:
+//
+//	tests := []struct {
+//		name    string
+//		wantErr errtest.ErrorAssertionFunc
+//	}{
+//		{name: "not found", wantErr: errtest.ErrorIs(ErrUserNotFound)},
+//		{name: "ok", wantErr: errtest.NoError},
+//	}
+//
+//	tt.wantErr(t, err)
+//
+// To pass adapter into field of assert.ErrorAssertionFunc type, convert it by Adapt:
+//
+//	wantErr: errtest.Adapt[assert.TestingT](errtest.ErrorIs(ErrUserNotFound))
+type ErrorAssertionFunc func(t TestingT, err error, msgAndArgs ...any) bool
+
+// Adapt converts assertion to the function with testing object of provided type.
+//
+// Result is assignable to testify assert.ErrorAssertionFunc (with T = assert.TestingT) and similar types of other libraries
+func Adapt[T TestingT](fn ErrorAssertionFunc) func(t T, err error, msgAndArgs ...any) bool {
+	return func(t T, err error, msgAndArgs ...any) bool {
+		return fn(t, err, msgAndArgs...)
+	}
+}
+
+// NoError asserts that error is nil
+func NoError(t TestingT, err error, msgAndArgs ...any) bool {
+	helper(t)
+
+	if err != nil {
+		fail(t, fmt.Sprintf("unexpected error:\n%s", chain(err)), msgAndArgs)
+		return false
+	}
+
+	return true
+}
+
+// AnyError asserts that error is not nil
+func AnyError(t TestingT, err error, msgAndArgs ...any) bool {
+	helper(t)
+
+	if err == nil {
+		fail(t, "expected error, got nil", msgAndArgs)
+		return false
+	}
+
+	return true
+}
+
+// ErrorIs returns assertion that target is in the chain of the error (by errorx.Is)
+func ErrorIs(target error) ErrorAssertionFunc {
+	return func(t TestingT, err error, msgAndArgs ...any) bool {
+		helper(t)
+
+		if !errorx.Is(err, target) {
+			fail(t, fmt.Sprintf("error chain does not contain target\nwant: %s\ngot:\n%s", describe(target), chain(err)), msgAndArgs)
+			return false
+		}
+
+		return true
+	}
+}
+
+// ErrorType returns assertion that type chain of the error matches pattern (see errorx.HasType)
+func ErrorType(pattern string) ErrorAssertionFunc {
+	return func(t TestingT, err error, msgAndArgs ...any) bool {
+		helper(t)
+
+		if !errorx.HasType(err, pattern) {
+			fail(t, fmt.Sprintf("error type does not match\nwant: %q\ngot:  %q\nchain:\n%s", pattern, errorx.Type(err), chain(err)), msgAndArgs)
+			return false
+		}
+
+		return true
+	}
+}
+
+// ErrorKind returns assertion of the error kind (see errorx.KindOf)
+func ErrorKind(kind errorx.Kind) ErrorAssertionFunc {
+	return func(t TestingT, err error, msgAndArgs ...any) bool {
+		helper(t)
+
+		if got := errorx.KindOf(err); got != kind {
+			fail(t, fmt.Sprintf("error kind does not match\nwant: %q\ngot:  %q\nchain:\n%s", kind, got, chain(err)), msgAndArgs)
+			return false
+		}
+
+		return true
+	}
+}
+
+// ErrorCode returns assertion of the error code (see errorx.CodeOf)
+func ErrorCode(code errorx.Code) ErrorAssertionFunc {
+	return func(t TestingT, err error, msgAndArgs ...any) bool {
+		helper(t)
+
+		if got := errorx.CodeOf(err); got != code {
+			fail(t, fmt.Sprintf("error code does not match\nwant: %q\ngot:  %q\nchain:\n%s", code, got, chain(err)), msgAndArgs)
+			return false
+		}
+
+		return true
+	}
+}
+
+// EqualError returns assertion that error is equal to the expected one (see errorx.Equal)
+func EqualError(want error, opts ...errorx.CompareOption) ErrorAssertionFunc {
+	return func(t TestingT, err error, msgAndArgs ...any) bool {
+		helper(t)
+
+		if !errorx.Equal(err, want, opts...) {
+			fail(t, fmt.Sprintf("errors are not equal\nwant:\n%s\ngot:\n%s\ndiff (got, want):\n%s", chain(want), chain(err), errorx.Diff(err, want)), msgAndArgs)
+			return false
+		}
+
+		return true
+	}
+}
+
+// helper marks caller as test helper if testing object supports it
+func helper(t TestingT) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+}
+
+// fail reports failure with optional message (in testify style: format and arguments)
+func fail(t TestingT, failure string, msgAndArgs []any) {
+	helper(t)
+
+	if message := formatMessage(msgAndArgs); message != "" {
+		failure = message + "\n" + failure
+	}
+
+	t.Errorf("%s", failure)
+}
+
+func formatMessage(msgAndArgs []any) string {
+	if len(msgAndArgs) == 0 {
+		return ""
+	}
+
+	if format, ok := msgAndArgs[0].(string); ok {
+		return fmt.Sprintf(format, msgAndArgs[1:]...)
+	}
+
+	return fmt.Sprint(msgAndArgs...)
+}
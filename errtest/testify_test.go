@@ -0,0 +1,74 @@
+package errtest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/boostgo/errorx"
+)
+
+// testifyT and testifyAssertion mirror assert.TestingT and assert.ErrorAssertionFunc of testify
+type testifyT interface {
+	Errorf(format string, args ...any)
+}
+
+type testifyAssertion func(t testifyT, err error, msgAndArgs ...any) bool
+
+// recorder records failures of assertions
+type recorder struct {
+	failures []string
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestAdapt(t *testing.T) {
+	notFound := errorx.Define("user not found")
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr testifyAssertion
+		want    bool
+	}{
+		{
+			name:    "no error",
+			err:     nil,
+			wantErr: Adapt[testifyT](NoError),
+			want:    true,
+		},
+		{
+			name:    "unexpected error",
+			err:     errors.New("oops"),
+			wantErr: Adapt[testifyT](NoError),
+			want:    false,
+		},
+		{
+			name:    "error is in the chain",
+			err:     errorx.Wrapped("Repo", notFound.Copy(errors.New("no rows")), "get user"),
+			wantErr: Adapt[testifyT](ErrorIs(notFound)),
+			want:    true,
+		},
+		{
+			name:    "error kind does not match",
+			err:     errorx.New("oops").SetKind(errorx.KindInternal),
+			wantErr: Adapt[testifyT](ErrorKind(errorx.KindNotFound)),
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{}
+			if got := tt.wantErr(r, tt.err); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+
+			if failed := len(r.failures) > 0; failed == tt.want {
+				t.Fatalf("failures %q do not match result %v", r.failures, tt.want)
+			}
+		})
+	}
+}
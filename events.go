@@ -0,0 +1,130 @@
+package errorx
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// EventType is type of the error event
+type EventType string
+
+const (
+	// EventCreate is emitted on every created error (see OnCreate)
+	EventCreate EventType = "create"
+	// EventWrap is emitted on every wrap by Wrap (and Wrap-like functions)
+	EventWrap EventType = "wrap"
+	// EventReport is emitted on every Report call
+	EventReport EventType = "report"
+)
+
+// Event is error event of the in-process event bus (see Subscribe)
+type Event struct {
+	Type EventType
+	Err  *Error
+	Time time.Time
+	// Origin is location ("file:line") of the code which caused event (first caller outside errorx)
+	Origin string
+}
+
+type subscriber struct {
+	id uint64
+	fn func(Event)
+}
+
+var subscribers struct {
+	mx     sync.Mutex
+	lastID uint64
+	list   atomic.Pointer[[]subscriber]
+}
+
+// Subscribe registers function which is called synchronously on every error event (create, wrap and report).
+//
+// Useful for custom dashboards, background aggregation or tests which assert that no errors were created.
+// Returns function which cancels subscription
+func Subscribe(fn func(Event)) (unsubscribe func()) {
+	if fn == nil {
+		return func() {}
+	}
+
+	subscribers.mx.Lock()
+	defer subscribers.mx.Unlock()
+
+	subscribers.lastID++
+	id := subscribers.lastID
+	list := currentSubscribers()
+	list = append(list, subscriber{id: id, fn: fn})
+	subscribers.list.Store(&list)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			subscribers.mx.Lock()
+			defer subscribers.mx.Unlock()
+
+			list := currentSubscribers()
+			for i, s := range list {
+				if s.id == id {
+					list = append(list[:i], list[i+1:]...)
+					break
+				}
+			}
+			subscribers.list.Store(&list)
+		})
+	}
+}
+
+// currentSubscribers returns copy of subscribers list
+func currentSubscribers() []subscriber {
+	list := make([]subscriber, 0)
+	if current := subscribers.list.Load(); current != nil {
+		list = append(list, *current...)
+	}
+
+	return list
+}
+
+// publish emits event to all subscribers. Panic inside subscriber is ignored
+func publish(eventType EventType, err *Error) {
+	list := subscribers.list.Load()
+	if list == nil || len(*list) == 0 {
+		return
+	}
+
+	event := Event{
+		Type:   eventType,
+		Err:    err,
+		Time:   time.Now(),
+		Origin: origin(),
+	}
+
+	for _, s := range *list {
+		func() {
+			defer func() {
+				_ = recover()
+			}()
+
+			s.fn(event)
+		}()
+	}
+}
+
+// origin returns location of the first caller outside errorx package
+func origin() string {
+	pc := make([]uintptr, 32)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "github.com/boostgo/errorx.") {
+			return frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package errorx
+
+import (
+	"slices"
+
+	"github.com/boostgo/convert"
+)
+
+// LayerDTO is transportable representation of one wrap level (see Layer): plain strings and maps,
+// ready to be sent to analytics systems or persisted in audit tables
+type LayerDTO struct {
+	Type    string            `json:"type,omitempty"`
+	Message string            `json:"message"`
+	Code    string            `json:"code,omitempty"`
+	Kind    string            `json:"kind,omitempty"`
+	Context map[string]string `json:"context,omitempty"`
+	Depth   int               `json:"depth"`
+}
+
+// Export flattens whole error chain to list of levels from the outer one to the inner (see Walk).
+//
+// Context values are converted to strings, sensitive values are masked (see SetRedactedKeys).
+// Code and kind are set on the outer level of every custom error. If provided error is nil - return nil.
+//
+// Result has no creation time and stack traces, so it is comparable by reflect.DeepEqual
+// (testify assert.Equal/ObjectsAreEqual) in tests
+func Export(err error) []LayerDTO {
+	if err == nil {
+		return nil
+	}
+
+	layers := make([]LayerDTO, 0)
+	var last error
+	Walk(err, func(layer Layer) bool {
+		dto := LayerDTO{
+			Type:    layer.Type,
+			Message: layer.Message,
+			Depth:   layer.Depth,
+		}
+
+		if custom, ok := layer.Err.(*Error); ok && layer.Err != last {
+			dto.Code = custom.code.String()
+			dto.Kind = custom.kind.String()
+		}
+		last = layer.Err
+
+		if len(layer.Context) > 0 {
+			dto.Context = make(map[string]string, len(layer.Context))
+			for key, value := range redactContext(layer.Context) {
+				dto.Context[key] = convert.String(value)
+			}
+		}
+
+		layers = append(layers, dto)
+		return true
+	})
+
+	return layers
+}
+
+// Import restores custom error from levels exported by Export. Levels are restored as levels of one error
+// (joined errors become one linear chain), the first found code and kind are set to the error.
+//
+// If no levels provided - return nil
+func Import(layers []LayerDTO) *Error {
+	if len(layers) == 0 {
+		return nil
+	}
+
+	inner := slices.Clone(layers)
+	slices.Reverse(inner)
+
+	var err *Error
+	for _, layer := range inner {
+		if err == nil {
+			err = newMessage(layer.Message)
+			err.levels[0].errType = internType(layer.Type)
+		} else {
+			err.levels = append(err.levels, level{errType: internType(layer.Type), message: layer.Message})
+		}
+
+		for key, value := range layer.Context {
+			err.setContextValue(key, value)
+		}
+	}
+
+	for _, layer := range layers {
+		if err.code == "" && layer.Code != "" {
+			err.code = Code(layer.Code)
+		}
+
+		if err.kind == KindUnknown && layer.Kind != "" {
+			err.kind = Kind(layer.Kind)
+		}
+	}
+
+	return notifyCreate(err)
+}
@@ -0,0 +1,72 @@
+package errorx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/boostgo/convert"
+)
+
+// FieldPath builds field path from segments: integers are indexes, other values are field names.
+//
+//	errorx.FieldPath("items", 2, "price") // "items[2].price"
+func FieldPath(segments ...any) string {
+	path := ""
+	for _, segment := range segments {
+		if index, ok := segment.(int); ok {
+			path += "[" + strconv.Itoa(index) + "]"
+			continue
+		}
+
+		path = JoinFieldPath(path, convert.String(segment))
+	}
+
+	return path
+}
+
+// JoinFieldPath joins parent path and path of the nested field:
+//
+//	errorx.JoinFieldPath("items[2]", "price") // "items[2].price"
+//	errorx.JoinFieldPath("items", "[2].price") // "items[2].price"
+func JoinFieldPath(parent, field string) string {
+	switch {
+	case parent == "":
+		return field
+	case field == "":
+		return parent
+	case strings.HasPrefix(field, "["):
+		return parent + field
+	default:
+		return parent + "." + field
+	}
+}
+
+// Prefix returns copy of field error with path prefixed by provided parent path
+func (f FieldError) Prefix(parent string) FieldError {
+	f.Field = JoinFieldPath(parent, f.Field)
+	return f
+}
+
+// PrefixFields prefixes paths of all field errors of the validation error by provided parent path.
+// Useful for composing validation of nested structs and slices:
+//
+//	for i, item := range order.Items {
+//		if err := validateItem(item); err != nil {
+//			return errorx.PrefixFields(errorx.FieldPath("items", i), err)
+//		}
+//	}
+//
+// Result is new validation error (see Validation). If provided error is not validation error - it is returned as is
+func PrefixFields(parent string, err error) error {
+	fields := FieldErrors(err)
+	if len(fields) == 0 {
+		return err
+	}
+
+	prefixed := make([]FieldError, 0, len(fields))
+	for _, field := range fields {
+		prefixed = append(prefixed, field.Prefix(parent))
+	}
+
+	return Validation(prefixed...)
+}
@@ -0,0 +1,59 @@
+package errorx
+
+// Filter returns only errors which satisfy provided predicate. Nil errors are skipped
+func Filter(errs []error, pred func(error) bool) []error {
+	filtered := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if err != nil && pred(err) {
+			filtered = append(filtered, err)
+		}
+	}
+
+	return filtered
+}
+
+// Partition splits provided error into errors which satisfy predicate and the rest of them.
+//
+// Join and MultiError structures (including nested ones) are expanded, so batch failures could be separated,
+// for example retryable failures from permanent ones:
+//
+//	retryable, permanent := errorx.Partition(err, errorx.IsRetryable)
+//
+// Both results are MultiError or nil if there are no errors in the group
+func Partition(err error, pred func(error) bool) (matched, rest error) {
+	var matchedErrs, restErrs *MultiError
+	for _, e := range expandAggregates(err) {
+		if pred(e) {
+			matchedErrs = Append(matchedErrs, e)
+		} else {
+			restErrs = Append(restErrs, e)
+		}
+	}
+
+	return matchedErrs.ErrorOrNil(), restErrs.ErrorOrNil()
+}
+
+// expandAggregates expands Join, MultiError and errors.Join structures (recursively) into list of errors.
+//
+// Custom errors are not expanded even if their inner error is aggregate
+func expandAggregates(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(*Error); ok {
+		return []error{err}
+	}
+
+	multi, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+
+	expanded := make([]error, 0)
+	for _, inner := range multi.Unwrap() {
+		expanded = append(expanded, expandAggregates(inner)...)
+	}
+
+	return expanded
+}
@@ -0,0 +1,62 @@
+package errorx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+)
+
+var (
+	uuidPattern   = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	hexPattern    = regexp.MustCompile(`0[xX][0-9a-fA-F]+`)
+	quotedPattern = regexp.MustCompile(`"[^"]*"|'[^']*'`)
+	numberPattern = regexp.MustCompile(`\d+`)
+)
+
+// Fingerprint returns stable hash of the error which groups occurrences of the same logical error.
+//
+// Hash is built from type chain, codes, kinds and normalized messages of the whole chain.
+// Context is ignored and volatile parts of messages (numbers, UUIDs, hex values, quoted strings) are replaced by placeholders,
+// so "user 42 not found" and "user 43 not found" have the same fingerprint
+func Fingerprint(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	builder := strings.Builder{}
+	walkChain(err, func(err error) bool {
+		switch e := err.(type) {
+		case *Error:
+			builder.WriteString("types:")
+			builder.WriteString(strings.Join(e.types(), "|"))
+			builder.WriteString(";code:")
+			builder.WriteString(e.code.String())
+			builder.WriteString(";kind:")
+			builder.WriteString(e.kind.String())
+			builder.WriteString(";message:")
+			builder.WriteString(normalizeMessage(strings.Join(e.messages(), "|")))
+			builder.WriteString("\n")
+		case interface{ Unwrap() error }, interface{ Unwrap() []error }:
+			// wrapper message contains messages of wrapped errors which are visited anyway
+		default:
+			builder.WriteString("error:")
+			builder.WriteString(normalizeMessage(err.Error()))
+			builder.WriteString("\n")
+		}
+
+		return true
+	})
+
+	hash := sha256.Sum256([]byte(builder.String()))
+	return hex.EncodeToString(hash[:16])
+}
+
+// normalizeMessage replaces volatile parts of the message by placeholders
+func normalizeMessage(message string) string {
+	message = uuidPattern.ReplaceAllString(message, "<uuid>")
+	message = hexPattern.ReplaceAllString(message, "<hex>")
+	message = quotedPattern.ReplaceAllString(message, "<str>")
+	message = numberPattern.ReplaceAllString(message, "<n>")
+	return message
+}
@@ -0,0 +1,108 @@
+package errorx
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format implements fmt.Formatter:
+//
+//	%s, %v - compact representation (same as Error())
+//	%q     - quoted compact representation
+//	%+v    - full multi-line chain: every layer with its type, message and context added on it, inner errors and stack trace
+//	%#v    - Go-syntax-ish dump of the error fields
+func (err *Error) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case f.Flag('+'):
+			err.writeDetailed(f)
+		case f.Flag('#'):
+			err.writeGoSyntax(f)
+		default:
+			_, _ = io.WriteString(f, err.Error())
+		}
+	case 's':
+		_, _ = io.WriteString(f, err.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(f, "%q", err.Error())
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(*errorx.Error=%s)", verb, err.Error())
+	}
+}
+
+// writeDetailed writes full multi-line chain of the error
+func (err *Error) writeDetailed(w io.Writer) {
+	for i := err.layerCount() - 1; i >= 0; i-- {
+		errType, message := err.levels[i].errType, err.levels[i].message
+		switch {
+		case errType != "" && message != "":
+			_, _ = fmt.Fprintf(w, "[%s] %s", errType, message)
+		case errType != "":
+			_, _ = fmt.Fprintf(w, "[%s]", errType)
+		default:
+			_, _ = io.WriteString(w, message)
+		}
+
+		layer := err.layer(i)
+		context := redactContext(layer.Context)
+		for _, key := range layer.Keys {
+			_, _ = fmt.Fprintf(w, "\n\t%s = %s", key, contextValueString(context[key]))
+		}
+
+		if i > 0 {
+			_, _ = io.WriteString(w, "\n")
+		}
+	}
+
+	if err.innerError != nil {
+		for i, inner := range innerErrors(err.innerError) {
+			_, _ = io.WriteString(w, "\ncaused by: ")
+			if len(innerErrors(err.innerError)) > 1 {
+				_, _ = fmt.Fprintf(w, "(%d) ", i+1)
+			}
+
+			_, _ = io.WriteString(w, strings.ReplaceAll(fmt.Sprintf("%+v", inner), "\n", "\n\t"))
+		}
+	}
+
+	if trace := err.renderedTrace(); len(trace) > 0 {
+		_, _ = io.WriteString(w, "\ntrace:")
+		for _, line := range trace {
+			_, _ = io.WriteString(w, "\n\t")
+			_, _ = io.WriteString(w, line)
+		}
+	}
+}
+
+// writeGoSyntax writes Go-syntax-ish dump of the error fields
+func (err *Error) writeGoSyntax(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "&errorx.Error{Message:%#v, Types:%#v", err.messages(), err.types())
+	if err.code != "" {
+		_, _ = fmt.Fprintf(w, ", Code:%q", err.code)
+	}
+	if err.kind != KindUnknown {
+		_, _ = fmt.Fprintf(w, ", Kind:%q", err.kind)
+	}
+	if len(err.tags) > 0 {
+		_, _ = fmt.Fprintf(w, ", Tags:%#v", err.tags)
+	}
+	if err.contextLen() > 0 {
+		_, _ = fmt.Fprintf(w, ", Context:%#v", redactContext(err.contextMap()))
+	}
+	if err.innerError != nil {
+		_, _ = fmt.Fprintf(w, ", Inner:%#v", err.innerError)
+	}
+	_, _ = io.WriteString(w, "}")
+}
+
+// innerErrors returns sub-errors of aggregated error or error itself
+func innerErrors(err error) []error {
+	if !isMultiError(err) {
+		return []error{err}
+	}
+
+	errs, _ := aggregated(err)
+	return errs
+}
@@ -0,0 +1,82 @@
+package errorx
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+)
+
+const (
+	// FSType is type of errors translated by TranslateFS
+	FSType = "FS"
+
+	PathKey    = "path"
+	NewPathKey = "new_path"
+	OpKey      = "op"
+)
+
+// fsMessages describes well-known file system and io errors
+var fsMessages = []struct {
+	target  error
+	message string
+}{
+	{fs.ErrNotExist, "file does not exist"},
+	{fs.ErrExist, "file already exists"},
+	{fs.ErrPermission, "permission denied"},
+	{fs.ErrInvalid, "invalid argument"},
+	{fs.ErrClosed, "file already closed"},
+	{os.ErrDeadlineExceeded, "i/o timeout"},
+	{io.ErrUnexpectedEOF, "unexpected end of file"},
+	{io.EOF, "end of file"},
+	{io.ErrShortWrite, "short write"},
+	{io.ErrShortBuffer, "short buffer"},
+	{io.ErrClosedPipe, "closed pipe"},
+	{io.ErrNoProgress, "no progress"},
+}
+
+// TranslateFS translates file system and io errors to classified custom error with FSType type.
+//
+// fs.ErrNotExist gets KindNotFound, fs.ErrExist - KindConflict, fs.ErrPermission - KindForbidden,
+// io.ErrUnexpectedEOF and fs.ErrInvalid - KindInvalid, closed files and short writes - KindInternal.
+// Path and operation of *fs.PathError (*os.LinkError) are stored in the context by PathKey, NewPathKey and OpKey.
+//
+// If error is not recognized - it is returned as is. If provided error is nil - return nil
+func TranslateFS(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	message := ""
+	for _, known := range fsMessages {
+		if errors.Is(err, known.target) {
+			message = known.message
+			break
+		}
+	}
+
+	if message == "" {
+		return err
+	}
+
+	translated := Get(Wrapped(FSType, err, message))
+	if kind := classifyKind(err, wellKnownKinds); kind != KindUnknown {
+		translated = translated.SetKind(kind)
+	}
+
+	var pathErr *fs.PathError
+	var linkErr *os.LinkError
+	switch {
+	case errors.As(err, &pathErr):
+		translated = translated.
+			AddContext(OpKey, pathErr.Op).
+			AddContext(PathKey, pathErr.Path)
+	case errors.As(err, &linkErr):
+		translated = translated.
+			AddContext(OpKey, linkErr.Op).
+			AddContext(PathKey, linkErr.Old).
+			AddContext(NewPathKey, linkErr.New)
+	}
+
+	return translated
+}
@@ -0,0 +1,35 @@
+package errorx
+
+import "context"
+
+// Go runs provided function in new goroutine with panic recovery (like Try).
+//
+// Result error (or nil) is delivered to the returned channel, after that channel is closed:
+//
+//	errCh := errorx.Go(func() error {
+//		return process()
+//	})
+//	...
+//	if err := <-errCh; err != nil {
+//		...
+//	}
+func Go(fn func() error) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		defer close(result)
+		result <- Try(fn)
+	}()
+
+	return result
+}
+
+// GoContext is like Go but provided function has context as an argument
+func GoContext(ctx context.Context, fn func(ctx context.Context) error) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		defer close(result)
+		result <- TryContext(ctx, fn)
+	}()
+
+	return result
+}
@@ -0,0 +1,69 @@
+package errorx
+
+import "strings"
+
+// GraphQLError is gqlerror-compatible representation of the error.
+//
+// Could be used in gqlgen error presenter:
+//
+//	srv.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
+//		converted := errorx.ToGraphQLError(err)
+//		return &gqlerror.Error{
+//			Message:    converted.Message,
+//			Path:       graphql.GetPath(ctx),
+//			Extensions: converted.Extensions,
+//		}
+//	})
+type GraphQLError struct {
+	Message    string         `json:"message"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// ToGraphQLError converts provided error to GraphQLError which is safe to send to clients.
+//
+// Message is user message of the error (see SetUserMessage) or generic message by kind ("not found", "internal error"),
+// internal messages are not exposed. Extensions contains only whitelisted context keys (see SetSafeKeys)
+// plus code and kind of the error
+func ToGraphQLError(err error) *GraphQLError {
+	if err == nil {
+		return nil
+	}
+
+	extensions := make(map[string]any)
+	if safe, ok := Safe(err).(*Error); ok {
+		for key, value := range redactContext(safe.Context()) {
+			extensions[key] = value
+		}
+	}
+
+	if code := CodeOf(err); code != "" {
+		extensions["code"] = code.String()
+	}
+
+	if kind := KindOf(err); kind != KindUnknown {
+		extensions["kind"] = kind.String()
+	}
+
+	if len(extensions) == 0 {
+		extensions = nil
+	}
+
+	return &GraphQLError{
+		Message:    publicMessage(err),
+		Extensions: extensions,
+	}
+}
+
+// publicMessage returns user message of the error or generic message by its kind
+func publicMessage(err error) string {
+	if message := UserMessage(err, ""); message != "" {
+		return message
+	}
+
+	kind := KindOf(err)
+	if kind == KindUnknown || kind == KindInternal {
+		return safeMessage
+	}
+
+	return strings.ReplaceAll(kind.String(), "_", " ")
+}
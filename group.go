@@ -0,0 +1,74 @@
+package errorx
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/boostgo/convert"
+)
+
+// Group is named set of context key-value pairs.
+//
+// Group serializes as nested object instead of flattened keys
+type Group map[string]any
+
+// AddGroup append group of key-value pairs to the current context map by provided name.
+//
+// If group with provided name already exists, pairs will be merged into it
+func (err *Error) AddGroup(name string, values map[string]any) *Error {
+	if name == "" || len(values) == 0 {
+		return err
+	}
+
+	err = err.derive()
+	value, _ := err.contextValue(name)
+	existing, _ := value.(Group)
+	group := make(Group, len(existing)+len(values))
+	for key, value := range existing {
+		group[key] = value
+	}
+
+	for key, value := range values {
+		if value == nil {
+			continue
+		}
+
+		group[key] = value
+	}
+
+	err.setContextValue(name, group)
+	return err
+}
+
+// String returns string representation of group: {key1=value1;key2=value2;}
+func (g Group) String() string {
+	keys := make([]string, 0, len(g))
+	for key := range g {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	builder := strings.Builder{}
+	builder.WriteString("{")
+	for _, key := range keys {
+		builder.WriteString(key)
+		builder.WriteString("=")
+		builder.WriteString(contextValueString(g[key]))
+		builder.WriteString(";")
+	}
+	builder.WriteString("}")
+
+	return builder.String()
+}
+
+// contextValueString converts context value to string
+func contextValueString(value any) string {
+	switch v := value.(type) {
+	case Group:
+		return v.String()
+	case map[string]any:
+		return Group(v).String()
+	default:
+		return convert.String(value)
+	}
+}
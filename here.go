@@ -0,0 +1,17 @@
+package errorx
+
+// NewHere creates new Error object with the name of the calling function as the type.
+//
+// Error type will be like "repository.(*UserRepository).GetByID", so it never drifts from the actual code location
+func NewHere(message string) *Error {
+	return notifyCreate(newMessage(message).SetType(callerFunction(1)))
+}
+
+// WrapHere works like Wrap, but error type is the name of the calling function
+func WrapHere(err *error, message string, ctx ...map[string]any) {
+	if err == nil || *err == nil {
+		return
+	}
+
+	Wrap(callerFunction(1), err, message, ctx...)
+}
@@ -0,0 +1,219 @@
+package httpx
+
+import (
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/boostgo/convert"
+	"github.com/boostgo/errorx"
+)
+
+var (
+	devMode   atomic.Bool
+	editorURL atomic.Value
+)
+
+// SetDevMode enables or disables development mode.
+//
+// In development mode HTMLError renders rich error page, otherwise safe JSON response
+func SetDevMode(enabled bool) {
+	devMode.Store(enabled)
+}
+
+// DevMode returns true if development mode is enabled
+func DevMode() bool {
+	return devMode.Load()
+}
+
+// SetEditorURL sets URL scheme for opening stack frames in the editor.
+//
+// Scheme could contain placeholders {file} and {line}, for example:
+//
+//	"vscode://file/{file}:{line}"
+//	"idea://open?file={file}&line={line}"
+func SetEditorURL(scheme string) {
+	editorURL.Store(scheme)
+}
+
+// HTMLError writes development-only error page with chain tree, context table and stack frames.
+//
+// If development mode is disabled - falls back to the safe JSON response
+func HTMLError(w http.ResponseWriter, r *http.Request, err error) {
+	if !DevMode() {
+		JSONError(w, r, err)
+		return
+	}
+
+	page := errorPage{
+		Title: defaultMessage,
+		Chain: newChainNode(err),
+	}
+
+	if r != nil {
+		page.Method = r.Method
+		page.URL = r.URL.String()
+	}
+
+	if custom, ok := errorx.TryGet(err); ok {
+		page.Title = custom.Message(1)
+		page.Context = newContextRows(custom.Redacted().Context())
+		page.Frames = newFrameRows(custom.Frames())
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(Status(err))
+	_ = errorPageTemplate.Execute(w, page)
+}
+
+type errorPage struct {
+	Title   string
+	Method  string
+	URL     string
+	Chain   chainNode
+	Context []contextRow
+	Frames  []frameRow
+}
+
+type chainNode struct {
+	Type     string
+	Message  string
+	Children []chainNode
+}
+
+type contextRow struct {
+	Key   string
+	Value string
+}
+
+type frameRow struct {
+	Function string
+	File     string
+	Line     int
+	Link     template.URL
+}
+
+// newChainNode builds tree of the error chain: custom errors, joined errors and wrapped errors
+func newChainNode(err error) chainNode {
+	if err == nil {
+		return chainNode{}
+	}
+
+	if custom, ok := err.(*errorx.Error); ok {
+		node := chainNode{
+			Type:    custom.Type(),
+			Message: custom.Message(),
+		}
+
+		if inner := custom.InnerError(); inner != nil {
+			node.Children = append(node.Children, newChainNode(inner))
+		}
+
+		return node
+	}
+
+	node := chainNode{
+		Message: err.Error(),
+	}
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() []error }:
+		node.Message = ""
+		for _, child := range wrapped.Unwrap() {
+			node.Children = append(node.Children, newChainNode(child))
+		}
+	case interface{ Unwrap() error }:
+		if child := wrapped.Unwrap(); child != nil {
+			node.Children = append(node.Children, newChainNode(child))
+		}
+	}
+
+	return node
+}
+
+func newContextRows(context map[string]any) []contextRow {
+	rows := make([]contextRow, 0, len(context))
+	for key, value := range context {
+		rows = append(rows, contextRow{
+			Key:   key,
+			Value: convert.String(value),
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Key < rows[j].Key
+	})
+
+	return rows
+}
+
+func newFrameRows(frames []errorx.Frame) []frameRow {
+	scheme, _ := editorURL.Load().(string)
+
+	rows := make([]frameRow, 0, len(frames))
+	for _, frame := range frames {
+		row := frameRow{
+			Function: frame.Function,
+			File:     frame.File,
+			Line:     frame.Line,
+		}
+
+		if scheme != "" {
+			row.Link = template.URL(strings.NewReplacer(
+				"{file}", frame.File,
+				"{line}", strconv.Itoa(frame.Line),
+			).Replace(scheme))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+var errorPageTemplate = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: -apple-system, sans-serif; margin: 2em; color: #222; }
+h1 { color: #c0392b; }
+.request { color: #777; }
+ul.chain { list-style: none; padding-left: 1.2em; border-left: 2px solid #eee; }
+.type { color: #8e44ad; font-weight: bold; }
+table { border-collapse: collapse; }
+td { border: 1px solid #ddd; padding: 4px 8px; font-family: monospace; vertical-align: top; }
+.frame { font-family: monospace; margin-bottom: 6px; }
+.file { color: #777; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+{{ if .Method }}<p class="request">{{ .Method }} {{ .URL }}</p>{{ end }}
+
+<h2>Chain</h2>
+<ul class="chain">{{ template "node" .Chain }}</ul>
+
+{{ if .Context }}
+<h2>Context</h2>
+<table>
+{{ range .Context }}<tr><td>{{ .Key }}</td><td>{{ .Value }}</td></tr>
+{{ end }}</table>
+{{ end }}
+
+{{ if .Frames }}
+<h2>Stack</h2>
+{{ range .Frames }}<div class="frame">{{ .Function }}<br>
+<span class="file">{{ if .Link }}<a href="{{ .Link }}">{{ .File }}:{{ .Line }}</a>{{ else }}{{ .File }}:{{ .Line }}{{ end }}</span></div>
+{{ end }}
+{{ end }}
+</body>
+</html>
+
+{{ define "node" }}<li>{{ if .Type }}<span class="type">[{{ .Type }}]</span> {{ end }}{{ .Message }}
+{{ if .Children }}<ul class="chain">{{ range .Children }}{{ template "node" . }}{{ end }}</ul>{{ end }}</li>{{ end }}
+`))
@@ -0,0 +1,64 @@
+package httpx
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/boostgo/errorx"
+)
+
+const (
+	defaultMessage = "internal server error"
+)
+
+// problem is safe error response body in "application/problem+json" format (RFC 9457).
+//
+// Response does not contain context, types and trace of the error
+type problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	Code   string `json:"code,omitempty"`
+	// Fields are invalid fields of the request (see errorx.Validation)
+	Fields []errorx.FieldError `json:"fields,omitempty"`
+}
+
+// JSONError writes safe error response in "application/problem+json" format.
+//
+// Status code is chosen by error kind (see Status). Response contains only user message and code of the error.
+// Internal messages, context, types and trace are not exposed. Field errors of validation errors are written as "fields".
+//
+// Detail is user message of the error (see errorx.SetUserMessage) translated by "Accept-Language" header.
+// If there is no user message, detail is omitted (server errors get generic "internal server error")
+func JSONError(w http.ResponseWriter, r *http.Request, err error) {
+	status := Status(err)
+	response := problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: errorx.UserMessage(err, language(r)),
+		Code:   errorx.CodeOf(err).String(),
+		Fields: errorx.FieldErrors(err),
+	}
+
+	if response.Detail == "" && status >= http.StatusInternalServerError {
+		response.Detail = defaultMessage
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// language returns the most preferred language of the "Accept-Language" header
+func language(r *http.Request) string {
+	if r == nil {
+		return ""
+	}
+
+	lang, _, _ := strings.Cut(r.Header.Get("Accept-Language"), ",")
+	lang, _, _ = strings.Cut(lang, ";")
+	return strings.TrimSpace(lang)
+}
@@ -0,0 +1,69 @@
+package httpx
+
+import (
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/boostgo/errorx"
+)
+
+var logger atomic.Pointer[slog.Logger]
+
+// SetLogger sets logger which is used by Recover middleware. By default slog.Default() is used
+func SetLogger(l *slog.Logger) {
+	logger.Store(l)
+}
+
+// Recover is middleware which catches panics in handlers and converts them by errorx.CatchPanic.
+//
+// Recovered panic is logged with structured trace and error response is written:
+// error page in development mode (see HTMLError) or 500 problem+json response in production.
+//
+// http.ErrAbortHandler is re-panicked to keep net/http behaviour
+func Recover(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			err := errorx.CatchPanic(recovered)
+			logPanic(r, err)
+			HTMLError(w, r, err)
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// logPanic logs recovered panic with request info and stack frames
+func logPanic(r *http.Request, err error) {
+	l := logger.Load()
+	if l == nil {
+		l = slog.Default()
+	}
+
+	message := err.Error()
+	frames := make([]errorx.Frame, 0)
+	if custom, ok := errorx.TryGet(err); ok {
+		if inner := custom.InnerError(); inner != nil {
+			message = inner.Error()
+		}
+		frames = custom.Frames()
+	}
+
+	attrs := []any{
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.String("panic", message),
+		slog.Any("stack", frames),
+	}
+
+	l.ErrorContext(r.Context(), "panic recovered", attrs...)
+}
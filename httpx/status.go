@@ -0,0 +1,40 @@
+package httpx
+
+import (
+	"net/http"
+
+	"github.com/boostgo/errorx"
+)
+
+// Status returns HTTP status code for provided error by its kind (see errorx.KindOf).
+//
+// If error has no kind - returns 500
+func Status(err error) int {
+	switch errorx.KindOf(err) {
+	case errorx.KindInvalid:
+		return http.StatusBadRequest
+	case errorx.KindUnauthorized:
+		return http.StatusUnauthorized
+	case errorx.KindForbidden:
+		return http.StatusForbidden
+	case errorx.KindNotFound:
+		return http.StatusNotFound
+	case errorx.KindConflict:
+		return http.StatusConflict
+	case errorx.KindTooManyRequests:
+		return http.StatusTooManyRequests
+	case errorx.KindCanceled:
+		return statusClientClosedRequest
+	case errorx.KindUnavailable:
+		return http.StatusServiceUnavailable
+	case errorx.KindTimeout:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+const (
+	// statusClientClosedRequest is non-standard status code (nginx) for requests canceled by client
+	statusClientClosedRequest = 499
+)
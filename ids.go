@@ -0,0 +1,79 @@
+package errorx
+
+import (
+	"time"
+
+	"github.com/boostgo/convert"
+)
+
+const (
+	RequestIDKey = "request_id"
+	TraceIDKey   = "trace_id"
+)
+
+// WithRequestID attaches request ID to the error context by RequestIDKey.
+//
+// If provided error is built-in, it will be wrapped by custom error
+func WithRequestID(err error, id string) error {
+	if err == nil || id == "" {
+		return err
+	}
+
+	return promote(err).AddContext(RequestIDKey, id)
+}
+
+// WithTraceID attaches trace ID to the error context by TraceIDKey.
+//
+// If provided error is built-in, it will be wrapped by custom error
+func WithTraceID(err error, id string) error {
+	if err == nil || id == "" {
+		return err
+	}
+
+	return promote(err).AddContext(TraceIDKey, id)
+}
+
+// RequestID returns request ID found in the chain of custom errors
+func RequestID(err error) string {
+	value, ok := ContextValue(err, RequestIDKey)
+	if !ok {
+		return ""
+	}
+
+	return convert.String(value)
+}
+
+// TraceID returns trace ID found in the chain of custom errors
+func TraceID(err error) string {
+	value, ok := ContextValue(err, TraceIDKey)
+	if !ok {
+		return ""
+	}
+
+	return convert.String(value)
+}
+
+// promote returns provided error as custom.
+//
+// If provided error is built-in, it returns new custom error without message which wraps provided one
+func promote(err error) *Error {
+	if custom, ok := err.(*Error); ok {
+		return custom
+	}
+
+	return &Error{
+		innerError: err,
+		createdAt:  time.Now(),
+	}
+}
+
+// detach returns copy of custom error or new custom error wrapping built-in one.
+//
+// Provided error is not modified, so result is safe to annotate even if provided error is shared
+func detach(err error) *Error {
+	if custom, ok := err.(*Error); ok {
+		return custom.clone()
+	}
+
+	return promote(err)
+}
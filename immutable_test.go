@@ -0,0 +1,164 @@
+package errorx
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// snapshot is comparable state of the error which must not be changed by setters
+type snapshot struct {
+	Layers []LayerDTO
+	Tags   []string
+	Kind   Kind
+	Code   Code
+	Text   string
+}
+
+func takeSnapshot(err *Error) snapshot {
+	return snapshot{
+		Layers: Export(err),
+		Tags:   slices.Clone(err.Tags()),
+		Kind:   err.Kind(),
+		Code:   err.Code(),
+		Text:   err.String(),
+	}
+}
+
+// newSentinel creates error whose slices have spare capacity, so appending setters could overwrite shared elements
+func newSentinel() *Error {
+	return Define("user not found").
+		SetType("Repo").
+		With("a", 1, "b", 2, "c", 3).
+		AddTag("first").
+		AddTag("second").
+		AddTag("third").
+		SetCode("USER_NOT_FOUND")
+}
+
+func TestImmutable(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(err *Error) *Error
+	}{
+		{name: "SetType", mutate: func(err *Error) *Error { return err.SetType("Usecase") }},
+		{name: "AddContext", mutate: func(err *Error) *Error { return err.AddContext("d", 4) }},
+		{name: "AddContext existing key", mutate: func(err *Error) *Error { return err.AddContext("a", 10) }},
+		{name: "SetContext", mutate: func(err *Error) *Error { return err.SetContext(map[string]any{"e": 5}) }},
+		{name: "With", mutate: func(err *Error) *Error { return err.With("f", 6, "g", 7) }},
+		{name: "AddGroup", mutate: func(err *Error) *Error { return err.AddGroup("request", map[string]any{"path": "/"}) }},
+		{name: "AddTag", mutate: func(err *Error) *Error { return err.AddTag("fourth") }},
+		{name: "SetCode", mutate: func(err *Error) *Error { return err.SetCode("OTHER") }},
+		{name: "SetKind", mutate: func(err *Error) *Error { return err.SetKind(KindNotFound) }},
+		{name: "SetError", mutate: func(err *Error) *Error { return err.SetError(fmt.Errorf("inner")) }},
+		{name: "SetTrace", mutate: func(err *Error) *Error { return err.SetTrace("main.go:1") }},
+		{
+			name: "Wrap",
+			mutate: func(err *Error) *Error {
+				wrapped := error(err)
+				Wrap("Usecase", &wrapped, "get user", map[string]any{"h": 8})
+				return Get(wrapped)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sentinel := newSentinel()
+			want := takeSnapshot(sentinel)
+
+			mutated := tt.mutate(sentinel)
+			if mutated == sentinel {
+				t.Fatalf("setter returned the same error")
+			}
+
+			if got := takeSnapshot(sentinel); !reflect.DeepEqual(got, want) {
+				t.Errorf("sentinel is changed\ngot:  %+v\nwant: %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestImmutableSiblings(t *testing.T) {
+	base := newSentinel()
+
+	first := base.AddContext("d", "first").SetType("First").AddTag("first-only")
+	second := base.AddContext("d", "second").SetType("Second").AddTag("second-only")
+
+	tests := []struct {
+		name     string
+		err      *Error
+		typ      string
+		value    any
+		tag      string
+		otherTag string
+	}{
+		{name: "first", err: first, typ: "First - Repo", value: "first", tag: "first-only", otherTag: "second-only"},
+		{name: "second", err: second, typ: "Second - Repo", value: "second", tag: "second-only", otherTag: "first-only"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Type(); got != tt.typ {
+				t.Errorf("Type() = %q, want %q", got, tt.typ)
+			}
+
+			if got := tt.err.Context()["d"]; got != tt.value {
+				t.Errorf("context value = %v, want %v", got, tt.value)
+			}
+
+			if tags := tt.err.Tags(); !slices.Contains(tags, tt.tag) || slices.Contains(tags, tt.otherTag) {
+				t.Errorf("Tags() = %v, want %q without %q", tags, tt.tag, tt.otherTag)
+			}
+		})
+	}
+}
+
+// TestImmutableConcurrent annotates shared sentinel from many goroutines (run with -race)
+func TestImmutableConcurrent(t *testing.T) {
+	sentinel := newSentinel()
+	want := takeSnapshot(sentinel)
+
+	const workers = 32
+	results := make([]*Error, workers)
+
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := error(sentinel.
+				AddContext("worker", i).
+				AddTag(fmt.Sprintf("worker-%d", i)))
+			Wrap("Usecase", &err, fmt.Sprintf("call %d", i))
+			results[i] = Get(err)
+			_ = results[i].Error()
+		}()
+	}
+	wg.Wait()
+
+	if got := takeSnapshot(sentinel); !reflect.DeepEqual(got, want) {
+		t.Fatalf("sentinel is changed\ngot:  %+v\nwant: %+v", got, want)
+	}
+
+	for i, err := range results {
+		if got := err.Context()["worker"]; got != i {
+			t.Errorf("worker %d: context value = %v", i, got)
+		}
+
+		if got, want := err.Message(), fmt.Sprintf("call %d - user not found", i); got != want {
+			t.Errorf("worker %d: Message() = %q, want %q", i, got, want)
+		}
+
+		if tags := err.Tags(); !slices.Contains(tags, fmt.Sprintf("worker-%d", i)) || len(tags) != len(want.Tags)+1 {
+			t.Errorf("worker %d: Tags() = %v", i, tags)
+		}
+
+		if !Is(err, sentinel) {
+			t.Errorf("worker %d: error does not match sentinel", i)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package errorx
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// causer is error of pkg/errors convention
+type causer struct {
+	message string
+	cause   error
+}
+
+func (err causer) Error() string { return err.message + ": " + err.cause.Error() }
+
+func (err causer) Cause() error { return err.cause }
+
+func TestIs(t *testing.T) {
+	defined := Define("user not found")
+	otherDefined := Define("user not found")
+
+	// wrapped wraps provided error by several custom layers
+	wrapped := func(err error) error {
+		Wrap("Repo", &err, "select")
+		Wrap("Usecase", &err, "get user")
+		return err
+	}
+
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{
+			name:   "copy of defined error",
+			err:    defined.Copy(sql.ErrNoRows),
+			target: defined,
+			want:   true,
+		},
+		{
+			name:   "annotated copy of defined error",
+			err:    Get(defined.Copy()).SetType("Repo").AddContext("id", 1),
+			target: defined,
+			want:   true,
+		},
+		{
+			name:   "wrapped copy of defined error",
+			err:    wrapped(defined.Copy()),
+			target: defined,
+			want:   true,
+		},
+		{
+			name:   "defined errors with the same text",
+			err:    otherDefined,
+			target: defined,
+			want:   false,
+		},
+		{
+			name:   "not defined error with the same text as defined one",
+			err:    New("user not found"),
+			target: defined,
+			want:   false,
+		},
+		{
+			name:   "errors with the same code",
+			err:    New("user 1 not found").SetCode("USER_NOT_FOUND"),
+			target: New("user not found").SetCode("USER_NOT_FOUND"),
+			want:   true,
+		},
+		{
+			name:   "errors with different codes",
+			err:    New("not found").SetCode("USER_NOT_FOUND"),
+			target: New("not found").SetCode("ORDER_NOT_FOUND"),
+			want:   false,
+		},
+		{
+			name:   "structurally equal errors",
+			err:    New("not found").SetType("Repo").AddContext("id", 1),
+			target: New("not found").SetType("Repo").AddContext("id", 1),
+			want:   true,
+		},
+		{
+			name:   "errors with different context",
+			err:    New("not found").AddContext("id", 1),
+			target: New("not found").AddContext("id", 2),
+			want:   false,
+		},
+		{
+			name:   "custom error in inner error",
+			err:    New("get user").SetError(ErrNotFound),
+			target: ErrNotFound,
+			want:   true,
+		},
+		{
+			name:   "built-in error buried several layers down",
+			err:    wrapped(fmt.Errorf("read body: %w", io.EOF)),
+			target: io.EOF,
+			want:   true,
+		},
+		{
+			name:   "built-in error in inner custom error",
+			err:    wrapped(New("select").SetError(New("scan").SetError(sql.ErrNoRows))),
+			target: sql.ErrNoRows,
+			want:   true,
+		},
+		{
+			name:   "built-in error in joined inner errors",
+			err:    wrapped(Join(io.ErrUnexpectedEOF, sql.ErrNoRows)),
+			target: sql.ErrNoRows,
+			want:   true,
+		},
+		{
+			name:   "built-in error is not in the chain",
+			err:    wrapped(io.EOF),
+			target: sql.ErrNoRows,
+			want:   false,
+		},
+		{
+			name:   "cause of pkg/errors error",
+			err:    wrapped(causer{message: "query", cause: sql.ErrNoRows}),
+			target: sql.ErrNoRows,
+			want:   true,
+		},
+		{
+			name:   "custom error in built-in chain",
+			err:    fmt.Errorf("handler: %w", wrapped(defined.Copy())),
+			target: defined,
+			want:   true,
+		},
+		{
+			name:   "nil error",
+			err:    nil,
+			target: io.EOF,
+			want:   false,
+		},
+		{
+			name:   "nil target",
+			err:    io.EOF,
+			target: nil,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("Is() = %v, want %v", got, tt.want)
+			}
+
+			// errors.Is must agree with Is, except chains which rely on pkg/errors Cause
+			if tt.err == nil || tt.target == nil || isCause(tt.err) {
+				return
+			}
+
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// isCause checks if error chain relies on pkg/errors Cause, which is not supported by errors.Is
+func isCause(err error) bool {
+	var c causer
+	return errors.As(err, &c)
+}
@@ -0,0 +1,21 @@
+package errorx
+
+import "iter"
+
+// All returns iterator over the whole chain of the error: current error, inner errors and joined errors (depth-first, like errors.Is):
+//
+//	for e := range errorx.Get(err).All() {
+//		fmt.Println(e)
+//	}
+func (err *Error) All() iter.Seq[error] {
+	return func(yield func(error) bool) {
+		walkChain(err, yield)
+	}
+}
+
+// LayersSeq returns iterator over levels of the whole chain of the error from the outer level to inner ones (see Walk)
+func (err *Error) LayersSeq() iter.Seq[Layer] {
+	return func(yield func(Layer) bool) {
+		walkLayers(err, 0, yield)
+	}
+}
@@ -1,22 +1,85 @@
 package errorx
 
-import "strings"
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
 
 type joinErrors struct {
 	errors []error
 }
 
-// Join all provided errors into one error
+// stdJoinType is type of errors returned by errors.Join
+var stdJoinType = reflect.TypeOf(errors.Join(errors.New("")))
+
+// Join all provided errors into one error.
+//
+// Nil errors are skipped, nested joined errors (by Join or errors.Join) are flattened,
+// custom errors are kept as is. If there are no errors - return nil, if there is only one error - it is returned as is.
+//
+// Result implements Unwrap() []error, like errors.Join
 func Join(errors ...error) error {
 	errsList := make([]error, 0, len(errors))
 	for _, err := range errors {
-		errsList = append(errsList, err)
+		errsList = appendJoined(errsList, err)
+	}
+
+	switch len(errsList) {
+	case 0:
+		return nil
+	case 1:
+		return errsList[0]
 	}
+
 	return &joinErrors{
 		errors: errsList,
 	}
 }
 
+// JoinInto joins provided errors into the error by pointer (see Join).
+//
+// Useful for accumulating errors in defer blocks:
+//
+//	defer func() {
+//		errorx.JoinInto(&err, file.Close())
+//	}()
+func JoinInto(err *error, errs ...error) {
+	if err == nil {
+		return
+	}
+
+	joined := make([]error, 0, len(errs)+1)
+	joined = append(joined, *err)
+	joined = append(joined, errs...)
+	*err = Join(joined...)
+}
+
+// appendJoined appends error to the list. Nested joined errors are flattened, nil errors are skipped
+func appendJoined(errsList []error, err error) []error {
+	if err == nil {
+		return errsList
+	}
+
+	var nested []error
+	switch joined := err.(type) {
+	case *joinErrors:
+		nested = joined.errors
+	default:
+		if reflect.TypeOf(err) != stdJoinType {
+			return append(errsList, err)
+		}
+
+		nested = err.(interface{ Unwrap() []error }).Unwrap()
+	}
+
+	for _, inner := range nested {
+		errsList = appendJoined(errsList, inner)
+	}
+
+	return errsList
+}
+
 // Unwrap return all errors slice
 func (je joinErrors) Unwrap() []error {
 	if je.errors == nil {
@@ -36,7 +99,7 @@ func (je joinErrors) Error() string {
 	for i := 0; i < len(je.errors); i++ {
 		message.WriteString(je.errors[i].Error())
 		if i < len(je.errors)-1 {
-			message.WriteString(" - ")
+			message.WriteString(separator())
 		}
 	}
 	return message.String()
@@ -0,0 +1,144 @@
+package errorx
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	first := errors.New("first")
+	second := New("second").SetType("Repo")
+	third := errors.New("third")
+
+	tests := []struct {
+		name string
+		errs []error
+		want []error
+	}{
+		{
+			name: "no errors",
+			errs: nil,
+			want: nil,
+		},
+		{
+			name: "only nil errors",
+			errs: []error{nil, nil},
+			want: nil,
+		},
+		{
+			name: "single error",
+			errs: []error{nil, first, nil},
+			want: []error{first},
+		},
+		{
+			name: "nil errors are skipped",
+			errs: []error{first, nil, second},
+			want: []error{first, second},
+		},
+		{
+			name: "nested Join is flattened",
+			errs: []error{Join(first, second), third},
+			want: []error{first, second, third},
+		},
+		{
+			name: "nested errors.Join is flattened",
+			errs: []error{first, errors.Join(second, nil, third)},
+			want: []error{first, second, third},
+		},
+		{
+			name: "deeply nested joins are flattened",
+			errs: []error{errors.Join(Join(first, errors.Join(second)), nil), third},
+			want: []error{first, second, third},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertJoined(t, Join(tt.errs...), tt.want)
+		})
+	}
+}
+
+func TestJoinInto(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+	third := errors.New("third")
+
+	tests := []struct {
+		name string
+		err  error
+		errs []error
+		want []error
+	}{
+		{
+			name: "nil error and nil errors",
+			err:  nil,
+			errs: []error{nil},
+			want: nil,
+		},
+		{
+			name: "nil error",
+			err:  nil,
+			errs: []error{first},
+			want: []error{first},
+		},
+		{
+			name: "nil errors keep error",
+			err:  first,
+			errs: []error{nil, nil},
+			want: []error{first},
+		},
+		{
+			name: "errors are appended",
+			err:  first,
+			errs: []error{second, third},
+			want: []error{first, second, third},
+		},
+		{
+			name: "joined error is flattened",
+			err:  Join(first, second),
+			errs: []error{third},
+			want: []error{first, second, third},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.err
+			JoinInto(&err, tt.errs...)
+			assertJoined(t, err, tt.want)
+		})
+	}
+
+	t.Run("nil pointer", func(t *testing.T) {
+		JoinInto(nil, first)
+	})
+}
+
+// assertJoined checks that error consists of wanted errors: nil for none, the error itself for one
+func assertJoined(t *testing.T, err error, want []error) {
+	t.Helper()
+
+	switch len(want) {
+	case 0:
+		if err != nil {
+			t.Fatalf("got %v, want nil", err)
+		}
+		return
+	case 1:
+		if err != want[0] {
+			t.Fatalf("got %v, want %v", err, want[0])
+		}
+		return
+	}
+
+	joined, ok := err.(*joinErrors)
+	if !ok {
+		t.Fatalf("got %T, want *joinErrors", err)
+	}
+
+	if !slices.Equal(joined.Unwrap(), want) {
+		t.Fatalf("got %v, want %v", joined.Unwrap(), want)
+	}
+}
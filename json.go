@@ -0,0 +1,59 @@
+package errorx
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// errorJSON is JSON representation of the Error
+type errorJSON struct {
+	Type       string         `json:"type,omitempty"`
+	Message    string         `json:"message"`
+	Code       Code           `json:"code,omitempty"`
+	Kind       Kind           `json:"kind,omitempty"`
+	Tags       []string       `json:"tags,omitempty"`
+	Context    map[string]any `json:"context,omitempty"`
+	Trace      []string       `json:"trace,omitempty"`
+	Created    *time.Time     `json:"created_at,omitempty"`
+	Inner      any            `json:"inner,omitempty"`
+	Suppressed []any          `json:"suppressed,omitempty"`
+}
+
+// MarshalJSON converts error to JSON object.
+//
+// Context groups are serialized as nested objects, sensitive context values are masked. Custom inner error serialized as nested error object,
+// built-in inner error serialized as string
+func (err *Error) MarshalJSON() ([]byte, error) {
+	view := errorJSON{
+		Type:    err.Type(),
+		Message: err.Message(),
+		Code:    err.code,
+		Kind:    err.kind,
+		Tags:    err.tags,
+		Context: redactContext(err.contextMap()),
+		Trace:   err.renderedTrace(),
+	}
+
+	if !err.createdAt.IsZero() {
+		view.Created = &err.createdAt
+	}
+
+	if err.innerError != nil {
+		view.Inner = jsonError(err.innerError)
+	}
+
+	for _, suppressed := range err.suppressed {
+		view.Suppressed = append(view.Suppressed, jsonError(suppressed))
+	}
+
+	return json.Marshal(view)
+}
+
+// jsonError returns custom error as is (it is marshaled as object) and built-in error as string
+func jsonError(err error) any {
+	if custom, ok := err.(*Error); ok {
+		return custom
+	}
+
+	return err.Error()
+}
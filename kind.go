@@ -0,0 +1,92 @@
+package errorx
+
+import (
+	"context"
+	"os"
+)
+
+// Kind is classification of the error, like "not found" or "timeout".
+//
+// Kind describes what happened (in opposite to type which describes where it happened)
+type Kind string
+
+const (
+	KindUnknown         Kind = ""
+	KindInvalid         Kind = "invalid"
+	KindUnauthorized    Kind = "unauthorized"
+	KindForbidden       Kind = "forbidden"
+	KindNotFound        Kind = "not_found"
+	KindConflict        Kind = "conflict"
+	KindTooManyRequests Kind = "too_many_requests"
+	KindCanceled        Kind = "canceled"
+	KindInternal        Kind = "internal"
+	KindUnavailable     Kind = "unavailable"
+	KindTimeout         Kind = "timeout"
+)
+
+// String returns kind as string
+func (k Kind) String() string {
+	return string(k)
+}
+
+// Kind returns kind of current error
+func (err *Error) Kind() Kind {
+	return err.kind
+}
+
+// SetKind sets kind of current error
+func (err *Error) SetKind(kind Kind) *Error {
+	err = err.derive()
+	err.kind = kind
+	return err
+}
+
+// KindOf returns first not empty kind found in the chain of custom errors.
+//
+// If provided error is built-in or has no kind - return KindUnknown
+func KindOf(err error) Kind {
+	for custom, ok := TryGet(err); ok; custom, ok = TryGet(custom.innerError) {
+		if custom.kind != KindUnknown {
+			return custom.kind
+		}
+	}
+
+	return KindUnknown
+}
+
+// IsCanceled checks if operation was canceled: chain (including joined errors) contains context.Canceled
+// or custom error with KindCanceled
+func IsCanceled(err error) bool {
+	return hasKind(err, KindCanceled, func(err error) bool {
+		return err == context.Canceled
+	})
+}
+
+// IsTimeout checks if operation timed out: chain (including joined errors) contains context.DeadlineExceeded,
+// os.ErrDeadlineExceeded, error with Timeout() method returning true (like net.Error) or custom error with KindTimeout
+func IsTimeout(err error) bool {
+	return hasKind(err, KindTimeout, func(err error) bool {
+		if err == context.DeadlineExceeded || err == os.ErrDeadlineExceeded {
+			return true
+		}
+
+		timeout, ok := err.(interface{ Timeout() bool })
+		return ok && timeout.Timeout()
+	})
+}
+
+// hasKind checks if any error in the whole chain is custom one with provided kind or matches provided function
+func hasKind(err error, kind Kind, match func(error) bool) bool {
+	found := false
+	walkChain(err, func(err error) bool {
+		if custom, ok := err.(*Error); ok {
+			found = custom.kind == kind
+		} else {
+			found = match(err)
+		}
+
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,203 @@
+package errorx
+
+// level is one wrap level of the error: type and message added by one Wrap (or by constructor).
+// Context fields refer to their level by index (see field)
+type level struct {
+	errType string
+	message string
+}
+
+// Layer is one wrap level of the error chain: type, message and context added on this level.
+//
+// Custom error keeps many levels (every Wrap adds one), built-in errors are levels too (without type and context)
+type Layer struct {
+	// Type is type of the level, empty if level has no type
+	Type string
+	// Message is message of the level (for built-in error - its text)
+	Message string
+	// Context is context added on this level
+	Context map[string]any
+	// Keys are keys of the context added on this level in insertion order
+	Keys []string
+	// Depth is nesting depth of the level, 0 is the outer level. Joined errors have the same depth
+	Depth int
+	// Err is error which level belongs to
+	Err error
+}
+
+// Walk traverses levels of the whole error chain from the outer level to the inner ones
+// (joined errors are traversed depth-first) and calls provided function for every level.
+// Traversal is bounded, so chains with cycles do not loop forever.
+//
+// Traversal stops when function returns false:
+//
+//	errorx.Walk(err, func(layer errorx.Layer) bool {
+//		fmt.Println(layer.Depth, layer.Type, layer.Message, layer.Context)
+//		return true
+//	})
+func Walk(err error, fn func(layer Layer) bool) {
+	walkLayers(err, 0, fn)
+}
+
+// LayerOf searches the first (outer) level of the whole chain with provided type.
+//
+// Useful to pull details of the specific level out of deep chain:
+//
+//	if layer, ok := errorx.LayerOf(err, "SQL"); ok {
+//		query := layer.Context["query"]
+//	}
+func LayerOf(err error, errType string) (Layer, bool) {
+	var (
+		found Layer
+		ok    bool
+	)
+
+	Walk(err, func(layer Layer) bool {
+		found, ok = layer, layer.Type == errType
+		return !ok
+	})
+
+	if !ok {
+		return Layer{}, false
+	}
+
+	return found, true
+}
+
+// walkLayers traverses levels of the error starting with provided depth. Returns false if traversal was stopped
+func walkLayers(err error, depth int, fn func(layer Layer) bool) bool {
+	if err == nil || depth > maxChainDepth {
+		return true
+	}
+
+	if custom, ok := err.(*Error); ok {
+		for index := custom.layerCount() - 1; index >= 0; index-- {
+			layer := custom.layer(index)
+			layer.Depth = depth
+			if !fn(layer) {
+				return false
+			}
+
+			depth++
+		}
+
+		if custom.cyclic() {
+			return true
+		}
+
+		return walkLayers(custom.innerError, depth, fn)
+	}
+
+	// joined errors are containers, not levels
+	if errs, ok := aggregated(err); ok {
+		for _, inner := range errs {
+			if !walkLayers(inner, depth, fn) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		if !fn(Layer{Message: err.Error(), Depth: depth, Err: err}) {
+			return false
+		}
+
+		return walkLayers(wrapped.Unwrap(), depth+1, fn)
+	case interface{ Cause() error }:
+		if !fn(Layer{Message: err.Error(), Depth: depth, Err: err}) {
+			return false
+		}
+
+		return walkLayers(wrapped.Cause(), depth+1, fn)
+	default:
+		return fn(Layer{Message: err.Error(), Depth: depth, Err: err})
+	}
+}
+
+// Layers returns wrap history of the error from the outer level (the last Wrap) to the inner one (where error was created)
+// with type, message and context added on every level.
+//
+// Only levels of the current error are returned, use Walk to traverse inner errors too
+func (err *Error) Layers() []Layer {
+	count := err.layerCount()
+	layers := make([]Layer, 0, count)
+	for index := count - 1; index >= 0; index-- {
+		layer := err.layer(index)
+		layer.Depth = count - 1 - index
+		layers = append(layers, layer)
+	}
+
+	return layers
+}
+
+// layerCount returns count of wrap levels of the error
+func (err *Error) layerCount() int {
+	return len(err.levels)
+}
+
+// currentLayer returns index of the last (outer) wrap level
+func (err *Error) currentLayer() int {
+	return max(err.layerCount()-1, 0)
+}
+
+// messages returns not empty messages of the levels from the inner level to the outer one
+func (err *Error) messages() []string {
+	messages := make([]string, 0, len(err.levels))
+	for _, l := range err.levels {
+		if l.message != "" {
+			messages = append(messages, l.message)
+		}
+	}
+
+	return messages
+}
+
+// types returns not empty types of the levels from the inner level to the outer one
+func (err *Error) types() []string {
+	types := make([]string, 0, len(err.levels))
+	for _, l := range err.levels {
+		if l.errType != "" {
+			types = append(types, l.errType)
+		}
+	}
+
+	return types
+}
+
+// hasType checks if any level of the error has type
+func (err *Error) hasType() bool {
+	for _, l := range err.levels {
+		if l.errType != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// layer returns wrap level by index (0 is the first, inner level) with context added on this level
+func (err *Error) layer(index int) Layer {
+	layer := Layer{
+		Type:    err.levels[index].errType,
+		Message: err.levels[index].message,
+		Err:     err,
+	}
+
+	for _, f := range err.fields {
+		if f.layer != index {
+			continue
+		}
+
+		if layer.Context == nil {
+			layer.Context = make(map[string]any)
+		}
+
+		layer.Context[f.key] = f.value
+		layer.Keys = append(layer.Keys, f.key)
+	}
+
+	return layer
+}
@@ -0,0 +1,207 @@
+package errorx
+
+import (
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// layerView is comparable part of the Layer
+type layerView struct {
+	Type    string
+	Message string
+	Keys    []string
+}
+
+func viewLayers(layers []Layer) []layerView {
+	views := make([]layerView, 0, len(layers))
+	for _, layer := range layers {
+		views = append(views, layerView{Type: layer.Type, Message: layer.Message, Keys: layer.Keys})
+	}
+
+	return views
+}
+
+func equalViews(a, b []layerView) bool {
+	return slices.EqualFunc(a, b, func(a, b layerView) bool {
+		return a.Type == b.Type && a.Message == b.Message && slices.Equal(a.Keys, b.Keys)
+	})
+}
+
+func TestLayers(t *testing.T) {
+	tests := []struct {
+		name string
+		err  func() error
+		want []layerView
+	}{
+		{
+			name: "new error",
+			err: func() error {
+				return New("a")
+			},
+			want: []layerView{{Message: "a"}},
+		},
+		{
+			name: "type of new error belongs to its level",
+			err: func() error {
+				return New("a").SetType("SQL").AddContext("query", "select")
+			},
+			want: []layerView{{Type: "SQL", Message: "a", Keys: []string{"query"}}},
+		},
+		{
+			name: "wrap with empty message",
+			err: func() error {
+				err := error(New("a").SetType("SQL"))
+				Wrap("Repo", &err, "")
+				Wrap("Usecase", &err, "usecase failed")
+				return err
+			},
+			want: []layerView{
+				{Type: "Usecase", Message: "usecase failed"},
+				{Type: "Repo"},
+				{Type: "SQL", Message: "a"},
+			},
+		},
+		{
+			name: "wrap with empty type",
+			err: func() error {
+				err := error(New("a").SetType("SQL"))
+				Wrap("", &err, "get user")
+				Wrap("Usecase", &err, "usecase failed")
+				return err
+			},
+			want: []layerView{
+				{Type: "Usecase", Message: "usecase failed"},
+				{Message: "get user"},
+				{Type: "SQL", Message: "a"},
+			},
+		},
+		{
+			name: "wrap with empty type and message keeps its context",
+			err: func() error {
+				err := error(New("a"))
+				Wrap("", &err, "", map[string]any{"id": 1})
+				Wrap("Usecase", &err, "usecase failed", map[string]any{"user": "john"})
+				return err
+			},
+			want: []layerView{
+				{Type: "Usecase", Message: "usecase failed", Keys: []string{"user"}},
+				{Keys: []string{"id"}},
+				{Message: "a"},
+			},
+		},
+		{
+			name: "wrap of built-in error",
+			err: func() error {
+				err := sql.ErrNoRows
+				Wrap("Repo", &err, "get user", map[string]any{"id": 1})
+				Wrap("Usecase", &err, "")
+				return err
+			},
+			want: []layerView{
+				{Type: "Usecase"},
+				{Type: "Repo", Message: "get user", Keys: []string{"id"}},
+			},
+		},
+		{
+			name: "import with outer level without type",
+			err: func() error {
+				return Import([]LayerDTO{
+					{Message: "outer", Context: map[string]string{"id": "1"}},
+					{Type: "SQL", Message: "inner"},
+				})
+			},
+			want: []layerView{
+				{Message: "outer", Keys: []string{"id"}},
+				{Type: "SQL", Message: "inner"},
+			},
+		},
+		{
+			name: "import with level without message",
+			err: func() error {
+				return Import([]LayerDTO{
+					{Type: "Usecase", Message: "usecase failed"},
+					{Type: "Repo"},
+					{Type: "SQL", Message: "a"},
+				})
+			},
+			want: []layerView{
+				{Type: "Usecase", Message: "usecase failed"},
+				{Type: "Repo"},
+				{Type: "SQL", Message: "a"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := viewLayers(Get(tt.err()).Layers())
+			if !equalViews(got, tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWalk(t *testing.T) {
+	err := errors.New("no rows")
+	Wrap("Repo", &err, "")
+	Wrap("Usecase", &err, "get user")
+
+	tests := []struct {
+		name string
+		err  error
+		want []layerView
+	}{
+		{
+			name: "custom and built-in levels",
+			err:  err,
+			want: []layerView{
+				{Type: "Usecase", Message: "get user"},
+				{Type: "Repo"},
+				{Message: "no rows"},
+			},
+		},
+		{
+			name: "joined errors are not levels",
+			err:  Join(New("a").SetType("A"), errors.New("b")),
+			want: []layerView{
+				{Type: "A", Message: "a"},
+				{Message: "b"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layers := make([]Layer, 0)
+			Walk(tt.err, func(layer Layer) bool {
+				layers = append(layers, layer)
+				return true
+			})
+
+			if got := viewLayers(layers); !equalViews(got, tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	err := error(New("a").SetType("SQL").AddContext("query", "select"))
+	Wrap("Repo", &err, "")
+	Wrap("", &err, "get user", map[string]any{"id": "1"})
+
+	imported := Import(Export(err))
+
+	got := viewLayers(imported.Layers())
+	want := viewLayers(Get(err).Layers())
+	if !equalViews(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	if imported.Error() != err.Error() {
+		t.Fatalf("got %q, want %q", imported.Error(), err.Error())
+	}
+}
@@ -0,0 +1,150 @@
+package errorx
+
+import (
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	DefaultSeparator = " - "
+)
+
+// Order is order of messages and types in joined representation
+type Order int32
+
+const (
+	// OrderDefault means order is not set and global order is used
+	OrderDefault Order = iota
+	// OrderReverse prints the last (outer) layer first: "User Usecase - User Repository - SQL"
+	OrderReverse
+	// OrderChronological prints the first (inner) layer first: "SQL - User Repository - User Usecase"
+	OrderChronological
+)
+
+var (
+	globalSeparator atomic.Pointer[string]
+	globalOrder     atomic.Int32
+
+	// layoutGeneration is changed on every global layout change, so cached joins become stale
+	layoutGeneration atomic.Uint64
+)
+
+func init() {
+	SetSeparator(DefaultSeparator)
+	SetOrder(OrderReverse)
+}
+
+// SetSeparator sets global separator of joined messages, types and joined errors. Default is " - "
+func SetSeparator(separator string) {
+	globalSeparator.Store(&separator)
+	layoutGeneration.Add(1)
+}
+
+// SetOrder sets global order of joined messages and types. Default is OrderReverse
+func SetOrder(order Order) {
+	if order == OrderDefault {
+		order = OrderReverse
+	}
+
+	globalOrder.Store(int32(order))
+	layoutGeneration.Add(1)
+}
+
+// SetSeparator sets separator of joined messages and types of the current error (overrides global one)
+func (err *Error) SetSeparator(separator string) *Error {
+	err = err.derive()
+	err.separator = &separator
+	return err
+}
+
+// SetOrder sets order of joined messages and types of the current error (overrides global one)
+func (err *Error) SetOrder(order Order) *Error {
+	err = err.derive()
+	err.order = order
+	return err
+}
+
+// joinLayers joins layers (messages or types) by separator and order of the error.
+//
+// If onlyFirst provided, only provided count of outer layers are joined
+func (err *Error) joinLayers(layers []string, onlyFirst ...int) string {
+	reversed := slices.Clone(layers)
+	slices.Reverse(reversed)
+
+	if len(onlyFirst) > 0 && onlyFirst[0] > 0 {
+		reversed = limitSlice(reversed, onlyFirst[0])
+	}
+
+	order := err.order
+	if order == OrderDefault {
+		order = Order(globalOrder.Load())
+	}
+
+	if order == OrderChronological {
+		slices.Reverse(reversed)
+	}
+
+	sep := separator()
+	if err.separator != nil {
+		sep = *err.separator
+	}
+
+	return strings.Join(reversed, sep)
+}
+
+// separator returns global separator
+func separator() string {
+	if s := globalSeparator.Load(); s != nil {
+		return *s
+	}
+
+	return DefaultSeparator
+}
+
+const (
+	layerMessage = iota
+	layerType
+)
+
+// layerStrings returns messages or types of the levels
+func (err *Error) layerStrings(layer int) []string {
+	if layer == layerType {
+		return err.types()
+	}
+
+	return err.messages()
+}
+
+// layerCache keeps joined messages and types of the error, because Error() could be called many times per error
+type layerCache [2]atomic.Pointer[joinedLayers]
+
+// joinedLayers is joined string of layers. It is valid while count of levels and global layout are the same
+type joinedLayers struct {
+	count      int
+	generation uint64
+	joined     string
+}
+
+// joinCached returns cached joined layers (messages or types) or joins them and stores result.
+//
+// Errors created without constructor have no cache - layers are joined every time
+func (err *Error) joinCached(layer int) string {
+	if err.cache == nil {
+		return err.joinLayers(err.layerStrings(layer))
+	}
+
+	slot := &err.cache[layer]
+	generation := layoutGeneration.Load()
+	if cached := slot.Load(); cached != nil && cached.count == len(err.levels) && cached.generation == generation {
+		return cached.joined
+	}
+
+	joined := err.joinLayers(err.layerStrings(layer))
+	slot.Store(&joinedLayers{
+		count:      len(err.levels),
+		generation: generation,
+		joined:     joined,
+	})
+	return joined
+}
@@ -0,0 +1,42 @@
+package errorx
+
+import (
+	"runtime/debug"
+	"sync/atomic"
+
+	"github.com/boostgo/convert"
+)
+
+var leanMode atomic.Bool
+
+// SetLeanMode enables or disables lean mode. Mode should be set once at program init.
+//
+// In lean mode stack traces are not captured (by CatchPanic, Promote, Must, WithStack), trace is not rendered
+// and context is limited by 8 keys (next keys are dropped), so errorx overhead is near zero
+// for latency-sensitive deployments
+func SetLeanMode(enabled bool) {
+	leanMode.Store(enabled)
+}
+
+// LeanMode returns true if lean mode is enabled
+func LeanMode() bool {
+	return leanMode.Load()
+}
+
+// captureStack returns stack trace of the current goroutine. In lean mode returns empty string
+func captureStack() string {
+	if leanMode.Load() {
+		return ""
+	}
+
+	return convert.String(debug.Stack())
+}
+
+// renderedTrace returns trace lines which should be rendered. In lean mode trace is not rendered
+func (err *Error) renderedTrace() []string {
+	if leanMode.Load() {
+		return nil
+	}
+
+	return err.trace
+}
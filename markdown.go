@@ -0,0 +1,78 @@
+package errorx
+
+import (
+	"strings"
+)
+
+// Markdown returns representation of the error for posting into alert channels (Slack, Teams, etc...):
+//
+//	**[User Repository - SQL]** get by id - query
+//	- user_id: `1`
+//	- query: `select ...`
+//
+//	Cause: `sql: no rows in result set`
+//
+//	```
+//	goroutine 1 [running]:
+//	...
+//	```
+//
+// Context is redacted (see SetRedactedKeys)
+func (err *Error) Markdown() string {
+	return err.markdown("**")
+}
+
+// Slack returns the same representation as Markdown() but in Slack "mrkdwn" dialect (bold by single asterisk)
+func (err *Error) Slack() string {
+	return err.markdown("*")
+}
+
+func (err *Error) markdown(bold string) string {
+	builder := strings.Builder{}
+
+	if err.hasType() {
+		builder.WriteString(bold + "[" + err.Type() + "]" + bold)
+		if message := err.Message(); message != "" {
+			builder.WriteString(" ")
+		}
+	}
+	builder.WriteString(err.Message())
+	builder.WriteString("\n")
+
+	if err.contextLen() > 0 {
+		context := redactContext(err.contextMap())
+		for _, key := range err.contextOrder() {
+			builder.WriteString("- ")
+			builder.WriteString(key)
+			builder.WriteString(": ")
+			builder.WriteString(inlineCode(contextValueString(context[key])))
+			builder.WriteString("\n")
+		}
+	}
+
+	if err.innerError != nil {
+		builder.WriteString("\nCause: ")
+		builder.WriteString(inlineCode(err.innerError.Error()))
+		builder.WriteString("\n")
+	}
+
+	if trace := err.renderedTrace(); len(trace) > 0 {
+		builder.WriteString("\n```\n")
+		for _, line := range trace {
+			builder.WriteString(strings.ReplaceAll(line, "```", "'''"))
+			builder.WriteString("\n")
+		}
+		builder.WriteString("```\n")
+	}
+
+	return strings.TrimRight(builder.String(), "\n")
+}
+
+// inlineCode wraps text into inline code block. Multi-line text is wrapped into fenced code block
+func inlineCode(text string) string {
+	if strings.Contains(text, "\n") {
+		return "\n```\n" + strings.ReplaceAll(text, "```", "'''") + "\n```"
+	}
+
+	return "`" + strings.ReplaceAll(text, "`", "'") + "`"
+}
@@ -0,0 +1,103 @@
+package errorx
+
+import (
+	"reflect"
+	"slices"
+)
+
+// Matcher is predicate for custom error
+type Matcher func(*Error) bool
+
+// Match checks if any custom error in the chain satisfies provided predicate.
+//
+// Predicates could be composed:
+//
+//	errorx.Match(err, errorx.And(errorx.MatchType("SQL"), errorx.MatchCode("E1001")))
+func Match(err error, pred func(*Error) bool) bool {
+	if pred == nil {
+		return false
+	}
+
+	for custom, ok := TryGet(err); ok; custom, ok = TryGet(custom.innerError) {
+		if pred(custom) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchType matches error which has provided type on any level of the type chain
+func MatchType(errorType string) Matcher {
+	return func(err *Error) bool {
+		return slices.ContainsFunc(err.levels, func(l level) bool {
+			return l.errType == errorType
+		})
+	}
+}
+
+// MatchCode matches error with provided code
+func MatchCode(code Code) Matcher {
+	return func(err *Error) bool {
+		return err.code == code
+	}
+}
+
+// MatchKind matches error with provided kind
+func MatchKind(kind Kind) Matcher {
+	return func(err *Error) bool {
+		return err.kind == kind
+	}
+}
+
+// MatchTag matches error with provided tag
+func MatchTag(tag string) Matcher {
+	return func(err *Error) bool {
+		return slices.Contains(err.tags, tag)
+	}
+}
+
+// MatchContext matches error which context contains provided key with provided value
+func MatchContext(key string, value any) Matcher {
+	return func(err *Error) bool {
+		existing, ok := err.contextValue(key)
+		if !ok {
+			return false
+		}
+
+		return reflect.DeepEqual(existing, value)
+	}
+}
+
+// And matches error which satisfies all provided matchers
+func And(matchers ...Matcher) Matcher {
+	return func(err *Error) bool {
+		for _, matcher := range matchers {
+			if !matcher(err) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Or matches error which satisfies at least one of provided matchers
+func Or(matchers ...Matcher) Matcher {
+	return func(err *Error) bool {
+		for _, matcher := range matchers {
+			if matcher(err) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Not matches error which does not satisfy provided matcher
+func Not(matcher Matcher) Matcher {
+	return func(err *Error) bool {
+		return !matcher(err)
+	}
+}
@@ -0,0 +1,137 @@
+package errorx
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync/atomic"
+)
+
+// MergePolicy describes how context values are merged when key already exists
+type MergePolicy int32
+
+const (
+	// MergeDefault means policy is not set and global policy is used
+	MergeDefault MergePolicy = iota
+	// MergeOverwrite replaces existing value with the new one
+	MergeOverwrite
+	// MergeKeepExisting keeps existing value and ignores the new one
+	MergeKeepExisting
+	// MergeErrorOnConflict keeps existing value and reports conflict if values are different
+	MergeErrorOnConflict
+	// MergeAppendSlice collects existing and new values into slice
+	MergeAppendSlice
+)
+
+var (
+	ErrContextConflict = errors.New("context conflict")
+
+	globalMergePolicy atomic.Int32
+)
+
+func init() {
+	globalMergePolicy.Store(int32(MergeOverwrite))
+}
+
+// SetMergePolicy sets global policy which is used by SetContext when error has no own policy.
+//
+// By default policy is MergeOverwrite
+func SetMergePolicy(policy MergePolicy) {
+	if policy == MergeDefault {
+		policy = MergeOverwrite
+	}
+
+	globalMergePolicy.Store(int32(policy))
+}
+
+// SetMergePolicy sets policy of current error which is used by SetContext
+func (err *Error) SetMergePolicy(policy MergePolicy) *Error {
+	err = err.derive()
+	err.mergePolicy = policy
+	return err
+}
+
+// MergeContext returns new error with all key-value pairs appended to the context map using provided policy.
+//
+// Returns ErrContextConflict error with conflicting keys if policy is MergeErrorOnConflict
+// and some keys already exist with different values. Not conflicting pairs are merged anyway
+func (err *Error) MergeContext(context map[string]any, policy MergePolicy) (*Error, error) {
+	if len(context) == 0 {
+		return err, nil
+	}
+
+	err = err.derive()
+	return err, err.mergeContext(context, policy)
+}
+
+// mergeContext merges key-value pairs into the current context map (without copying the error)
+func (err *Error) mergeContext(context map[string]any, policy MergePolicy) error {
+	if len(context) == 0 {
+		return nil
+	}
+
+	if policy == MergeDefault {
+		policy = err.policy()
+	}
+
+	keys := make([]string, 0, len(context))
+	for key := range context {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	conflicts := make([]string, 0)
+	for _, key := range keys {
+		if !err.mergeValue(key, context[key], policy) {
+			conflicts = append(conflicts, key)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return New("merge context").
+			SetError(ErrContextConflict).
+			AddContext("keys", conflicts)
+	}
+
+	return nil
+}
+
+// policy returns merge policy of current error or global policy if it is not set
+func (err *Error) policy() MergePolicy {
+	if err.mergePolicy != MergeDefault {
+		return err.mergePolicy
+	}
+
+	return MergePolicy(globalMergePolicy.Load())
+}
+
+// mergeValue sets value by provided policy. Returns false if there is conflict
+func (err *Error) mergeValue(key string, value any, policy MergePolicy) bool {
+	existing, exist := err.contextValue(key)
+	if !exist {
+		err.setContextValue(key, value)
+		return true
+	}
+
+	switch policy {
+	case MergeKeepExisting:
+		return true
+	case MergeErrorOnConflict:
+		return reflect.DeepEqual(existing, value)
+	case MergeAppendSlice:
+		if value == nil {
+			return true
+		}
+
+		values, ok := existing.([]any)
+		if !ok {
+			values = []any{existing}
+		}
+
+		err.setContextValue(key, append(values[:len(values):len(values)], value))
+		return true
+	default:
+		err.setContextValue(key, value)
+		return true
+	}
+}
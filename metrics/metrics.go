@@ -0,0 +1,202 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/boostgo/errorx"
+)
+
+const (
+	metricName = "errors_total"
+)
+
+// Labels are labels of errors counter
+type Labels struct {
+	Type string
+	Kind string
+	Code string
+}
+
+// Collector counts errors by type, kind and code and exposes them in Prometheus text format.
+//
+// Package does not depend on Prometheus client, so to register counters in client_golang registry
+// wrap collector by small prometheus.Collector adapter:
+//
+//	type errorsCollector struct {
+//		desc *prometheus.Desc
+//	}
+//
+//	func (c errorsCollector) Describe(ch chan<- *prometheus.Desc) {
+//		ch <- c.desc
+//	}
+//
+//	func (c errorsCollector) Collect(ch chan<- prometheus.Metric) {
+//		for labels, value := range metrics.Default.All() {
+//			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.CounterValue, float64(value), labels.Type, labels.Kind, labels.Code)
+//		}
+//	}
+//
+//	prometheus.MustRegister(errorsCollector{
+//		desc: prometheus.NewDesc("errors_total", "Total number of errors by type, kind and code.", []string{"type", "kind", "code"}, nil),
+//	})
+type Collector struct {
+	mx       sync.RWMutex
+	counters map[Labels]uint64
+}
+
+// NewCollector creates new empty collector
+func NewCollector() *Collector {
+	return &Collector{
+		counters: make(map[Labels]uint64),
+	}
+}
+
+// Default is collector which is registered by Enable
+var Default = NewCollector()
+
+var enableOnce sync.Once
+
+// Enable registers create hook (see errorx.OnCreate) which counts every created error by Default collector.
+//
+// Error is counted with type, kind and code which it has on creation: constructors which take them
+// (errorx.Wrap of built-in error, errorx.NewWith, errorx.E, errorx.Promote, templates) are counted with full labels,
+// but New(...).SetType(...) is counted before setters are applied. Use Collector.Observe at boundaries
+// to count final errors instead.
+//
+// Calling more than once has no effect
+func Enable() {
+	enableOnce.Do(func() {
+		errorx.OnCreate(func(err *errorx.Error) {
+			Default.Observe(err)
+		})
+	})
+}
+
+// Handler returns HTTP handler which exposes Default collector counters
+func Handler() http.Handler {
+	return Default
+}
+
+// Observe counts provided error. Useful at boundaries (handlers, workers) where final error is known
+func (c *Collector) Observe(err error) {
+	if err == nil {
+		return
+	}
+
+	c.inc(labelsOf(err))
+}
+
+// All returns iterator over snapshot of counters (labels and values)
+func (c *Collector) All() iter.Seq2[Labels, uint64] {
+	c.mx.RLock()
+	values := make(map[Labels]uint64, len(c.counters))
+	for l, value := range c.counters {
+		values[l] = value
+	}
+	c.mx.RUnlock()
+
+	return func(yield func(Labels, uint64) bool) {
+		for l, value := range values {
+			if !yield(l, value) {
+				return
+			}
+		}
+	}
+}
+
+// Count returns counter value by provided labels
+func (c *Collector) Count(labels Labels) uint64 {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return c.counters[labels]
+}
+
+// Reset resets all counters
+func (c *Collector) Reset() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.counters = make(map[Labels]uint64)
+}
+
+// ServeHTTP writes counters in Prometheus text exposition format:
+//
+//	# HELP errors_total Total number of errors by type, kind and code.
+//	# TYPE errors_total counter
+//	errors_total{type="SQL",kind="not_found",code="USER_NOT_FOUND"} 3
+func (c *Collector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = c.Write(w)
+}
+
+// Write writes counters in Prometheus text exposition format
+func (c *Collector) Write(w io.Writer) error {
+	c.mx.RLock()
+	labels := make([]Labels, 0, len(c.counters))
+	for l := range c.counters {
+		labels = append(labels, l)
+	}
+	values := make(map[Labels]uint64, len(c.counters))
+	for l, value := range c.counters {
+		values[l] = value
+	}
+	c.mx.RUnlock()
+
+	sort.Slice(labels, func(i, j int) bool {
+		if labels[i].Type != labels[j].Type {
+			return labels[i].Type < labels[j].Type
+		}
+
+		if labels[i].Kind != labels[j].Kind {
+			return labels[i].Kind < labels[j].Kind
+		}
+
+		return labels[i].Code < labels[j].Code
+	})
+
+	builder := strings.Builder{}
+	builder.WriteString("# HELP " + metricName + " Total number of errors by type, kind and code.\n")
+	builder.WriteString("# TYPE " + metricName + " counter\n")
+	for _, l := range labels {
+		_, _ = fmt.Fprintf(&builder, "%s{type=\"%s\",kind=\"%s\",code=\"%s\"} %d\n",
+			metricName,
+			escapeLabel(l.Type),
+			escapeLabel(l.Kind),
+			escapeLabel(l.Code),
+			values[l],
+		)
+	}
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+func (c *Collector) inc(labels Labels) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.counters[labels]++
+}
+
+// labelsOf returns labels of the error: outer type, first kind and first code found in the chain
+func labelsOf(err error) Labels {
+	return Labels{
+		Type: errorx.Type(err),
+		Kind: errorx.KindOf(err).String(),
+		Code: errorx.CodeOf(err).String(),
+	}
+}
+
+// escapeLabel escapes label value by Prometheus text format rules
+var escapeLabel = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+).Replace
@@ -0,0 +1,161 @@
+package errorx
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MultiError is a list of errors accumulated by Append.
+//
+// Useful for validation loops and batch operations:
+//
+//	var result *errorx.MultiError
+//	for _, item := range items {
+//		if err := process(item); err != nil {
+//			result = errorx.Append(result, err)
+//		}
+//	}
+//	return result.ErrorOrNil()
+type MultiError struct {
+	errors []error
+}
+
+// Append appends provided errors to the err and returns MultiError.
+//
+// If err is MultiError - its errors are copied into the new one, otherwise err becomes first error of the list.
+// Nil errors are skipped, nested MultiError errors are flattened
+func Append(err error, errs ...error) *MultiError {
+	multi := &MultiError{
+		errors: make([]error, 0, len(errs)+1),
+	}
+
+	multi.append(err)
+	for _, e := range errs {
+		multi.append(e)
+	}
+
+	return multi
+}
+
+// Errors returns copy of the accumulated errors
+func (me *MultiError) Errors() []error {
+	if me == nil {
+		return nil
+	}
+
+	return slices.Clone(me.errors)
+}
+
+// Len returns count of the accumulated errors
+func (me *MultiError) Len() int {
+	if me == nil {
+		return 0
+	}
+
+	return len(me.errors)
+}
+
+// ErrorOrNil returns nil if there are no accumulated errors, otherwise returns MultiError itself.
+//
+// Use it when returning MultiError as error to avoid non-nil interface with nil value
+func (me *MultiError) ErrorOrNil() error {
+	if me == nil || len(me.errors) == 0 {
+		return nil
+	}
+
+	return me
+}
+
+// Error join all accumulated errors into one string
+func (me *MultiError) Error() string {
+	if me == nil || len(me.errors) == 0 {
+		return ""
+	}
+
+	message := strings.Builder{}
+	for i := 0; i < len(me.errors); i++ {
+		message.WriteString(me.errors[i].Error())
+		if i < len(me.errors)-1 {
+			message.WriteString(separator())
+		}
+	}
+	return message.String()
+}
+
+// Unwrap return all accumulated errors, so errors.Is and errors.As see through MultiError
+func (me *MultiError) Unwrap() []error {
+	if me == nil {
+		return nil
+	}
+
+	return me.errors
+}
+
+// append appends error to the list skipping nil errors and flattening nested MultiError
+func (me *MultiError) append(err error) {
+	if err == nil {
+		return
+	}
+
+	if multi, ok := err.(*MultiError); ok {
+		if multi == nil {
+			return
+		}
+
+		me.errors = append(me.errors, multi.errors...)
+		return
+	}
+
+	me.errors = append(me.errors, err)
+}
+
+// isMultiError checks if provided error is aggregate of several errors
+// (join, MultiError, errors.Join, hashicorp/go-multierror, uber multierr)
+func isMultiError(err error) bool {
+	errs, ok := aggregated(err)
+	return ok && len(errs) > 1
+}
+
+// aggregated returns sub-errors of aggregated error: Unwrap() []error (stdlib convention),
+// WrappedErrors() []error (hashicorp/go-multierror) or Errors() []error (uber multierr).
+// Custom error is not aggregate
+func aggregated(err error) ([]error, bool) {
+	switch multi := err.(type) {
+	case *Error:
+		return nil, false
+	case interface{ Unwrap() []error }:
+		return multi.Unwrap(), true
+	case interface{ WrappedErrors() []error }:
+		return multi.WrappedErrors(), true
+	case interface{ Errors() []error }:
+		return multi.Errors(), true
+	default:
+		return nil, false
+	}
+}
+
+// writeInnerError writes inner error to the builder.
+//
+// Aggregated errors are written as numbered list, every sub-error on its own indented line:
+//
+//  1. not found
+//  2. conflict
+func writeInnerError(builder *strings.Builder, err error) {
+	if !isMultiError(err) {
+		builder.WriteString(err.Error())
+		return
+	}
+
+	for i, sub := range innerErrors(err) {
+		builder.WriteString("\n\t")
+		builder.WriteString(strconv.Itoa(i + 1))
+		builder.WriteString(". ")
+
+		message := "<nil>"
+		if sub != nil {
+			message = sub.Error()
+		}
+		builder.WriteString(strings.ReplaceAll(message, "\n", "\n\t"))
+	}
+}
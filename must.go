@@ -0,0 +1,32 @@
+package errorx
+
+// Must returns provided value if error is nil, otherwise panics with custom error wrapping provided one.
+//
+// Useful for initialization code:
+//
+//	var config = errorx.Must(loadConfig())
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(New("must").
+			SetError(err).
+			SetTrace(captureStack()))
+	}
+
+	return value
+}
+
+// Ensure returns error with provided message and context if condition is false (invariant is broken).
+//
+// Returned error has KindInternal. If condition is true - return nil
+func Ensure(condition bool, message string, ctx ...map[string]any) error {
+	if condition {
+		return nil
+	}
+
+	err := New(message).SetKind(KindInternal)
+	for _, context := range ctx {
+		err = err.SetContext(context)
+	}
+
+	return err
+}
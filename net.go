@@ -0,0 +1,140 @@
+package errorx
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"strconv"
+	"syscall"
+)
+
+const (
+	// NetType is type of errors translated by ClassifyNet
+	NetType = "Net"
+
+	HostKey = "host"
+	PortKey = "port"
+)
+
+// refusedErrors are syscall errors which mean that remote side is unavailable at the moment
+var refusedErrors = []error{
+	syscall.ECONNREFUSED,
+	syscall.ECONNRESET,
+	syscall.ECONNABORTED,
+	syscall.EHOSTUNREACH,
+	syscall.ENETUNREACH,
+	syscall.EPIPE,
+}
+
+// ClassifyNet translates network error to classified custom error with NetType type.
+//
+// Timeouts get KindTimeout, refused/reset connections, DNS and TLS handshake failures - KindUnavailable.
+// Timeouts, refused connections and temporary DNS failures are marked as retryable,
+// TLS failures (bad certificates) and unknown hosts - as permanent.
+// Host and port of *net.OpError (or host of *net.DNSError) are stored in the context by HostKey and PortKey.
+//
+// If error is not recognized - it is returned as is. If provided error is nil - return nil
+func ClassifyNet(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	message, kind, retryable, ok := classifyNet(err)
+	if !ok {
+		return err
+	}
+
+	classified := Get(Wrapped(NetType, err, message)).
+		SetKind(kind).
+		SetRetryable(retryable)
+
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	switch {
+	case errors.As(err, &opErr):
+		classified = classified.AddContext(OpKey, opErr.Op)
+		if opErr.Addr != nil {
+			classified = withAddress(classified, opErr.Addr.String())
+		}
+	case errors.As(err, &dnsErr):
+		classified = classified.AddContext(HostKey, dnsErr.Name)
+	}
+
+	return classified
+}
+
+// classifyNet returns message, kind and retryable flag of network error
+func classifyNet(err error) (message string, kind Kind, retryable bool, ok bool) {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		switch {
+		case dnsErr.IsTimeout:
+			return "dns lookup timeout", KindTimeout, true, true
+		case dnsErr.IsNotFound:
+			return "host not found", KindUnavailable, false, true
+		default:
+			return "dns lookup failed", KindUnavailable, dnsErr.IsTemporary, true
+		}
+	}
+
+	if isTLSError(err) {
+		return "tls handshake failed", KindUnavailable, false, true
+	}
+
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return "network timeout", KindTimeout, true, true
+	}
+
+	for _, refused := range refusedErrors {
+		if errors.Is(err, refused) {
+			return "connection refused", KindUnavailable, true, true
+		}
+	}
+
+	if errors.Is(err, net.ErrClosed) {
+		return "connection closed", KindUnavailable, true, true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return "network error", KindUnavailable, true, true
+	}
+
+	return "", KindUnknown, false, false
+}
+
+// isTLSError checks if error is TLS handshake or certificate verification failure
+func isTLSError(err error) bool {
+	var (
+		recordErr    tls.RecordHeaderError
+		alertErr     tls.AlertError
+		verifyErr    *tls.CertificateVerificationError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+	)
+
+	return errors.As(err, &recordErr) ||
+		errors.As(err, &alertErr) ||
+		errors.As(err, &verifyErr) ||
+		errors.As(err, &authorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr)
+}
+
+// withAddress adds host and port of provided address to the context
+func withAddress(err *Error, address string) *Error {
+	host, port, e := net.SplitHostPort(address)
+	if e != nil {
+		return err.AddContext(HostKey, address)
+	}
+
+	err = err.AddContext(HostKey, host)
+	if number, e := strconv.Atoi(port); e == nil {
+		return err.AddContext(PortKey, number)
+	}
+
+	return err.AddContext(PortKey, port)
+}
@@ -0,0 +1,137 @@
+package errorx
+
+import (
+	"crypto/x509"
+	"errors"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestClassifyNet(t *testing.T) {
+	dial := func(err error) error {
+		return &net.OpError{
+			Op:   "dial",
+			Net:  "tcp",
+			Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5432},
+			Err:  err,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		err       error
+		message   string
+		kind      Kind
+		retryable bool
+		context   map[string]any
+	}{
+		{
+			name:      "connection refused",
+			err:       dial(os.NewSyscallError("connect", syscall.ECONNREFUSED)),
+			message:   "connection refused",
+			kind:      KindUnavailable,
+			retryable: true,
+			context:   map[string]any{OpKey: "dial", HostKey: "10.0.0.1", PortKey: 5432},
+		},
+		{
+			name:      "connection reset",
+			err:       os.NewSyscallError("read", syscall.ECONNRESET),
+			message:   "connection refused",
+			kind:      KindUnavailable,
+			retryable: true,
+		},
+		{
+			name:      "dial timeout",
+			err:       dial(os.ErrDeadlineExceeded),
+			message:   "network timeout",
+			kind:      KindTimeout,
+			retryable: true,
+			context:   map[string]any{OpKey: "dial", HostKey: "10.0.0.1", PortKey: 5432},
+		},
+		{
+			name:      "dns timeout",
+			err:       &net.DNSError{Name: "db.local", IsTimeout: true},
+			message:   "dns lookup timeout",
+			kind:      KindTimeout,
+			retryable: true,
+			context:   map[string]any{HostKey: "db.local"},
+		},
+		{
+			name:    "unknown host",
+			err:     &net.DNSError{Name: "db.local", IsNotFound: true},
+			message: "host not found",
+			kind:    KindUnavailable,
+			context: map[string]any{HostKey: "db.local"},
+		},
+		{
+			name:      "temporary dns failure",
+			err:       &net.DNSError{Name: "db.local", IsTemporary: true},
+			message:   "dns lookup failed",
+			kind:      KindUnavailable,
+			retryable: true,
+			context:   map[string]any{HostKey: "db.local"},
+		},
+		{
+			name:    "tls certificate failure",
+			err:     dial(x509.UnknownAuthorityError{}),
+			message: "tls handshake failed",
+			kind:    KindUnavailable,
+			context: map[string]any{OpKey: "dial", HostKey: "10.0.0.1", PortKey: 5432},
+		},
+		{
+			name:      "closed connection",
+			err:       net.ErrClosed,
+			message:   "connection closed",
+			kind:      KindUnavailable,
+			retryable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classified := ClassifyNet(tt.err)
+
+			custom, ok := TryGet(classified)
+			if !ok {
+				t.Fatalf("ClassifyNet() = %v, want custom error", classified)
+			}
+
+			if custom.Type() != NetType || custom.Message(1) != tt.message {
+				t.Errorf("ClassifyNet() = [%s] %s, want [%s] %s", custom.Type(), custom.Message(1), NetType, tt.message)
+			}
+
+			if got := KindOf(classified); got != tt.kind {
+				t.Errorf("KindOf() = %q, want %q", got, tt.kind)
+			}
+
+			if got := IsRetryable(classified); got != tt.retryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.retryable)
+			}
+
+			for key, want := range tt.context {
+				if got, _ := ContextValue(classified, key); got != want {
+					t.Errorf("context %q = %v, want %v", key, got, want)
+				}
+			}
+
+			if !errors.Is(classified, tt.err) {
+				t.Errorf("classified error does not wrap original one")
+			}
+		})
+	}
+
+	t.Run("not network error", func(t *testing.T) {
+		err := errors.New("oops")
+		if got := ClassifyNet(err); got != err {
+			t.Errorf("ClassifyNet() = %v, want error as is", got)
+		}
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		if got := ClassifyNet(nil); got != nil {
+			t.Errorf("ClassifyNet() = %v, want nil", got)
+		}
+	})
+}
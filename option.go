@@ -0,0 +1,65 @@
+package errorx
+
+// Option sets up error created by NewWith and returns it
+type Option func(err *Error) *Error
+
+// NewWith creates new Error object with provided message and applies options to it:
+//
+//	errorx.NewWith("get user",
+//		errorx.WithType("User Repository"),
+//		errorx.WithKind(errorx.KindNotFound),
+//		errorx.WithContext(map[string]any{"user_id": id}),
+//		errorx.WithInner(err),
+//	)
+func NewWith(message string, opts ...Option) *Error {
+	err := newMessage(message)
+	for _, opt := range opts {
+		if opt != nil {
+			err = opt(err)
+		}
+	}
+
+	return notifyCreate(err)
+}
+
+// WithType sets type of the error
+func WithType(errType string) Option {
+	return func(err *Error) *Error {
+		return err.SetType(errType)
+	}
+}
+
+// WithCode sets code of the error
+func WithCode(code Code) Option {
+	return func(err *Error) *Error {
+		return err.SetCode(code)
+	}
+}
+
+// WithKind sets kind of the error
+func WithKind(kind Kind) Option {
+	return func(err *Error) *Error {
+		return err.SetKind(kind)
+	}
+}
+
+// WithContext merges provided context into the error context
+func WithContext(context map[string]any) Option {
+	return func(err *Error) *Error {
+		return err.SetContext(context)
+	}
+}
+
+// WithInner sets inner errors. If there are more than 1 error, they are joined
+func WithInner(innerErrors ...error) Option {
+	return func(err *Error) *Error {
+		return err.SetError(innerErrors...)
+	}
+}
+
+// WithStack sets stack trace of the current goroutine as trace of the error
+func WithStack() Option {
+	return func(err *Error) *Error {
+		return err.SetTrace(captureStack())
+	}
+}
@@ -0,0 +1,150 @@
+package errorx
+
+import (
+	"maps"
+	"slices"
+)
+
+const (
+	// smallContextSize is max count of context fields which are searched linearly, without index map
+	smallContextSize = 8
+)
+
+// field is one key-value pair of the error context
+type field struct {
+	key   string
+	value any
+	// layer is index of the wrap level which set the value (see Layer)
+	layer int
+}
+
+// contextValue returns context value by provided key
+func (err *Error) contextValue(key string) (any, bool) {
+	if err.index != nil {
+		position, ok := err.index[key]
+		if !ok {
+			return nil, false
+		}
+
+		return err.fields[position].value, true
+	}
+
+	for _, f := range err.fields {
+		if f.key == key {
+			return f.value, true
+		}
+	}
+
+	return nil, false
+}
+
+// contextLen returns count of context fields
+func (err *Error) contextLen() int {
+	return len(err.fields)
+}
+
+// contextMap returns context fields as new map
+func (err *Error) contextMap() map[string]any {
+	context := make(map[string]any, len(err.fields))
+	for _, f := range err.fields {
+		context[f.key] = f.value
+	}
+
+	return context
+}
+
+// setContextValue sets value to the context and remembers insertion order of the key
+func (err *Error) setContextValue(key string, value any) {
+	if key == traceKey {
+		err.migrateTrace(value)
+		return
+	}
+
+	err.ownContext()
+
+	// error without levels (see promote) gets empty level, so context belongs to some level
+	if len(err.levels) == 0 {
+		err.levels = append(err.levels, level{})
+	}
+
+	if position := err.contextPosition(key); position >= 0 {
+		err.fields[position].value = value
+		err.fields[position].layer = err.currentLayer()
+		return
+	}
+
+	if leanMode.Load() && len(err.fields) >= smallContextSize {
+		return
+	}
+
+	err.fields = append(err.fields, field{key: key, value: value, layer: err.currentLayer()})
+	if err.index != nil {
+		err.index[key] = len(err.fields) - 1
+	} else if len(err.fields) > smallContextSize {
+		err.reindex()
+	}
+}
+
+// deleteContextValue removes value from the context
+func (err *Error) deleteContextValue(key string) {
+	position := err.contextPosition(key)
+	if position < 0 {
+		return
+	}
+
+	err.ownContext()
+	err.fields = slices.Delete(err.fields, position, position+1)
+	if err.index != nil {
+		err.reindex()
+	}
+}
+
+// contextPosition returns position of the field by provided key or -1
+func (err *Error) contextPosition(key string) int {
+	if err.index != nil {
+		position, ok := err.index[key]
+		if !ok {
+			return -1
+		}
+
+		return position
+	}
+
+	return slices.IndexFunc(err.fields, func(f field) bool {
+		return f.key == key
+	})
+}
+
+// reindex builds index map for big context. Small context is searched linearly
+func (err *Error) reindex() {
+	if len(err.fields) <= smallContextSize {
+		err.index = nil
+		return
+	}
+
+	err.index = make(map[string]int, len(err.fields))
+	for position, f := range err.fields {
+		err.index[f.key] = position
+	}
+}
+
+// ownContext copies context fields if they are shared with another error (see derive)
+func (err *Error) ownContext() {
+	if !err.sharedContext {
+		return
+	}
+
+	err.fields = slices.Clone(err.fields)
+	err.index = maps.Clone(err.index)
+	err.sharedContext = false
+}
+
+// contextOrder returns context keys in insertion order
+func (err *Error) contextOrder() []string {
+	keys := make([]string, 0, len(err.fields))
+	for _, f := range err.fields {
+		keys = append(keys, f.key)
+	}
+
+	return keys
+}
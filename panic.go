@@ -0,0 +1,91 @@
+package errorx
+
+import (
+	"runtime"
+	"sync"
+)
+
+// PanicHook is callback which is called on every recovered panic
+type PanicHook func(recovered any, stack []Frame)
+
+var panicHooks struct {
+	mx    sync.RWMutex
+	hooks []PanicHook
+}
+
+var repanicPolicy struct {
+	mx         sync.RWMutex
+	predicates []func(recovered any) bool
+}
+
+// OnPanic registers global hook which is called by CatchPanic (so by Try and all Try-like functions)
+// on every recovered panic.
+//
+// Useful for paging or emitting metrics without wrapping every call site:
+//
+//	errorx.OnPanic(func(recovered any, stack []errorx.Frame) {
+//		panicsTotal.Inc()
+//	})
+func OnPanic(hook PanicHook) {
+	if hook == nil {
+		return
+	}
+
+	panicHooks.mx.Lock()
+	defer panicHooks.mx.Unlock()
+
+	panicHooks.hooks = append(panicHooks.hooks, hook)
+}
+
+// notifyPanic calls all registered panic hooks. Panic inside hook is ignored
+func notifyPanic(recovered any, stack []Frame) {
+	panicHooks.mx.RLock()
+	hooks := panicHooks.hooks
+	panicHooks.mx.RUnlock()
+
+	for _, hook := range hooks {
+		func() {
+			defer func() {
+				_ = recover()
+			}()
+
+			hook(recovered, stack)
+		}()
+	}
+}
+
+// SetRepanicPolicy sets predicates which decide if recovered panic must be re-panicked
+// by CatchPanic (and so by Try and all Try-like functions) instead of converting it to error.
+//
+// Masking memory-corruption-class panics as normal errors is dangerous, so it is recommended to re-panic runtime errors:
+//
+//	errorx.SetRepanicPolicy(errorx.RuntimeErrors)
+//
+// Panic hooks are called before re-panic. Calling without predicates disables re-panic (default behaviour)
+func SetRepanicPolicy(predicates ...func(recovered any) bool) {
+	repanicPolicy.mx.Lock()
+	defer repanicPolicy.mx.Unlock()
+
+	repanicPolicy.predicates = predicates
+}
+
+// RuntimeErrors is re-panic predicate which matches runtime errors (nil dereference, index out of range, etc.)
+func RuntimeErrors(recovered any) bool {
+	_, ok := recovered.(runtime.Error)
+	return ok
+}
+
+// mustRepanic checks if recovered value matches any of re-panic predicates
+func mustRepanic(recovered any) bool {
+	repanicPolicy.mx.RLock()
+	predicates := repanicPolicy.predicates
+	repanicPolicy.mx.RUnlock()
+
+	for _, predicate := range predicates {
+		if predicate != nil && predicate(recovered) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package errorx
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	ansiReset   = "\033[0m"
+	ansiBold    = "\033[1m"
+	ansiRed     = "\033[31m"
+	ansiYellow  = "\033[33m"
+	ansiMagenta = "\033[35m"
+	ansiCyan    = "\033[36m"
+	ansiGray    = "\033[90m"
+)
+
+// prettyStyle colorizes types, messages and context keys by ANSI escape codes
+var prettyStyle = style{
+	errorType: colorize(ansiBold + ansiMagenta),
+	message:   colorize(ansiRed),
+	key:       colorize(ansiCyan),
+}
+
+// Pretty returns colorized (by ANSI escape codes) representation of the error for terminal output
+// during local development.
+//
+// Output is the same tree as Verbose() returns plus stack frames of the trace
+func (err *Error) Pretty() string {
+	builder := strings.Builder{}
+	writeVerbose(&builder, err, 0, prettyStyle)
+
+	frames := err.Frames()
+	if len(frames) > 0 {
+		builder.WriteString(colorize(ansiBold + ansiYellow)("stack:"))
+		builder.WriteString("\n")
+		for _, frame := range frames {
+			builder.WriteString(verboseIndent)
+			builder.WriteString(colorize(ansiYellow)(frame.Function))
+			builder.WriteString("\n")
+			builder.WriteString(verboseIndent + verboseIndent)
+			builder.WriteString(colorize(ansiGray)(frame.File + ":" + strconv.Itoa(frame.Line)))
+			builder.WriteString("\n")
+		}
+	}
+
+	return strings.TrimRight(builder.String(), "\n")
+}
+
+// colorize returns function which wraps text by provided ANSI color code
+func colorize(color string) func(string) string {
+	return func(text string) string {
+		if text == "" {
+			return text
+		}
+
+		return color + text + ansiReset
+	}
+}
@@ -0,0 +1,43 @@
+package errorx
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+const (
+	ArgsKey = "args"
+)
+
+var captureArgs atomic.Bool
+
+// SetCaptureArgs enables or disables capturing of format arguments of Newf and Wrapf into context by ArgsKey
+func SetCaptureArgs(enabled bool) {
+	captureArgs.Store(enabled)
+}
+
+// Newf creates new Error object with message formatted by fmt.Sprintf.
+//
+// Create hooks are called with created error, arguments are already captured (see SetCaptureArgs)
+func Newf(format string, args ...any) *Error {
+	err := newMessage(fmt.Sprintf(format, args...))
+	if captureArgs.Load() && len(args) > 0 {
+		err.setContextValue(ArgsKey, args)
+	}
+
+	return notifyCreate(err)
+}
+
+// Wrapf works like Wrap, but message is formatted by fmt.Sprintf
+func Wrapf(errType string, err *error, format string, args ...any) {
+	if *err == nil {
+		return
+	}
+
+	var ctx map[string]any
+	if captureArgs.Load() && len(args) > 0 {
+		ctx = map[string]any{ArgsKey: args}
+	}
+
+	Wrap(errType, err, fmt.Sprintf(format, args...), ctx)
+}
@@ -0,0 +1,105 @@
+package errorx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+)
+
+const (
+	CallerKey = "caller"
+)
+
+// knownKind is kind of well-known standard library error
+type knownKind struct {
+	target error
+	kind   Kind
+}
+
+// wrapKinds classifies standard library errors by Wrap. List is short on purpose: every Wrap checks it,
+// and server-side failures (fs, io) must not become client errors (see wellKnownKinds)
+var wrapKinds = []knownKind{
+	{sql.ErrNoRows, KindNotFound},
+	{sql.ErrTxDone, KindInternal},
+	{context.Canceled, KindCanceled},
+	{context.DeadlineExceeded, KindTimeout},
+}
+
+// wellKnownKinds classifies well-known standard library errors by Promote
+var wellKnownKinds = []knownKind{
+	{sql.ErrNoRows, KindNotFound},
+	{sql.ErrTxDone, KindInternal},
+	{sql.ErrConnDone, KindUnavailable},
+	{fs.ErrNotExist, KindNotFound},
+	{fs.ErrExist, KindConflict},
+	{fs.ErrPermission, KindForbidden},
+	{fs.ErrInvalid, KindInvalid},
+	{fs.ErrClosed, KindInternal},
+	{context.Canceled, KindCanceled},
+	{context.DeadlineExceeded, KindTimeout},
+	{os.ErrDeadlineExceeded, KindTimeout},
+	{io.ErrUnexpectedEOF, KindInvalid},
+	{io.EOF, KindInvalid},
+	{io.ErrShortWrite, KindInternal},
+	{io.ErrShortBuffer, KindInternal},
+	{io.ErrClosedPipe, KindInternal},
+	{io.ErrNoProgress, KindInternal},
+}
+
+// Promote converts built-in error to custom one with provided type.
+//
+// Stack trace and name of the calling function (by CallerKey) are captured.
+// Well-known standard library errors are classified by kind:
+// sql.ErrNoRows and fs.ErrNotExist - KindNotFound, sql.ErrTxDone - KindInternal, context.Canceled - KindCanceled,
+// context.DeadlineExceeded - KindTimeout, io.EOF - KindInvalid and so on.
+//
+// If provided error is already custom - it is returned as is. If provided error is nil - return nil
+func Promote(err error, errType string) *Error {
+	if err == nil {
+		return nil
+	}
+
+	if custom, ok := err.(*Error); ok {
+		return custom
+	}
+
+	promoted := promote(err).
+		SetType(errType).
+		SetKind(classifyKind(err, wellKnownKinds)).
+		SetTrace(captureStack())
+
+	if caller := callerFunction(1); caller != "" {
+		promoted = promoted.AddContext(CallerKey, caller)
+	}
+
+	return notifyCreate(promoted)
+}
+
+// classifyKind returns kind of standard library error by provided list. If error is unknown - return KindUnknown
+func classifyKind(err error, kinds []knownKind) Kind {
+	for _, known := range kinds {
+		if errors.Is(err, known.target) {
+			return known.kind
+		}
+	}
+
+	return KindUnknown
+}
+
+// classify sets kind of standard library error in the chain (see wrapKinds)
+// if nobody in the chain of custom errors classified it yet
+func classify(err *Error) *Error {
+	if KindOf(err) != KindUnknown {
+		return err
+	}
+
+	kind := classifyKind(err, wrapKinds)
+	if kind == KindUnknown {
+		return err
+	}
+
+	return err.SetKind(kind)
+}
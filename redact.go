@@ -0,0 +1,86 @@
+package errorx
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	RedactedValue = "[REDACTED]"
+)
+
+var redactedKeys atomic.Pointer[map[string]struct{}]
+
+// SetRedactedKeys sets context keys which values must be masked before output.
+//
+// Keys compares case-insensitive. Masking applies to String(), JSON marshaling, slog output (see LogValue) and Redacted() view.
+// Calling without keys disables redaction
+func SetRedactedKeys(keys ...string) {
+	if len(keys) == 0 {
+		redactedKeys.Store(nil)
+		return
+	}
+
+	set := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		set[strings.ToLower(key)] = struct{}{}
+	}
+
+	redactedKeys.Store(&set)
+}
+
+// IsRedactedKey returns true if values by provided context key must be masked
+func IsRedactedKey(key string) bool {
+	set := redactedKeys.Load()
+	if set == nil {
+		return false
+	}
+
+	_, ok := (*set)[strings.ToLower(key)]
+	return ok
+}
+
+// Redacted returns copy of the error with masked sensitive context values.
+//
+// Inner custom errors are redacted too
+func (err *Error) Redacted() *Error {
+	redacted := err.clone()
+	context := redactContext(err.contextMap())
+	for i := range redacted.fields {
+		redacted.fields[i].value = context[redacted.fields[i].key]
+	}
+
+	if inner, ok := err.innerError.(*Error); ok {
+		redacted.innerError = inner.Redacted()
+	}
+
+	return redacted
+}
+
+// redactContext returns context with masked sensitive values (including values inside groups).
+//
+// If there are no redacted keys - returns provided context as is
+func redactContext(context map[string]any) map[string]any {
+	if redactedKeys.Load() == nil || len(context) == 0 {
+		return context
+	}
+
+	redacted := make(map[string]any, len(context))
+	for key, value := range context {
+		if IsRedactedKey(key) {
+			redacted[key] = RedactedValue
+			continue
+		}
+
+		switch group := value.(type) {
+		case Group:
+			redacted[key] = Group(redactContext(group))
+		case map[string]any:
+			redacted[key] = redactContext(group)
+		default:
+			redacted[key] = value
+		}
+	}
+
+	return redacted
+}
@@ -0,0 +1,139 @@
+package redisx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/boostgo/errorx"
+)
+
+const (
+	// Type is type of errors translated by Translate
+	Type = "Redis"
+
+	SlotKey = "redis_slot"
+	NodeKey = "redis_node"
+)
+
+// failure is classification of the redis error
+type failure struct {
+	message   string
+	kind      errorx.Kind
+	retryable bool
+}
+
+// clientErrors classifies go-redis client errors by text (redis.Nil, redis.TxFailedErr, pool errors)
+var clientErrors = map[string]failure{
+	"redis: nil":                     {message: "key not found", kind: errorx.KindNotFound},
+	"redis: transaction failed":      {message: "transaction failed", kind: errorx.KindConflict, retryable: true},
+	"redis: connection pool timeout": {message: "connection pool timeout", kind: errorx.KindTimeout, retryable: true},
+	"redis: client is closed":        {message: "client closed", kind: errorx.KindUnavailable},
+	"redis: connection pool is full": {message: "connection pool is full", kind: errorx.KindTooManyRequests, retryable: true},
+}
+
+// serverErrors classifies redis server errors by reply prefix.
+//
+// One word prefix is compared with the first token of the reply (error code), so "BUSY" does not match "BUSYGROUP".
+// Prefix of several words is compared with the beginning of the reply
+var serverErrors = []struct {
+	prefix string
+	failure
+}{
+	{"MOVED", failure{message: "slot moved", kind: errorx.KindUnavailable, retryable: true}},
+	{"ASK", failure{message: "slot migrating", kind: errorx.KindUnavailable, retryable: true}},
+	{"CLUSTERDOWN", failure{message: "cluster down", kind: errorx.KindUnavailable, retryable: true}},
+	{"TRYAGAIN", failure{message: "try again", kind: errorx.KindUnavailable, retryable: true}},
+	{"LOADING", failure{message: "dataset loading", kind: errorx.KindUnavailable, retryable: true}},
+	{"MASTERDOWN", failure{message: "master down", kind: errorx.KindUnavailable, retryable: true}},
+	{"READONLY", failure{message: "read only replica", kind: errorx.KindUnavailable, retryable: true}},
+	{"BUSY", failure{message: "server busy", kind: errorx.KindUnavailable, retryable: true}},
+	{"BUSYGROUP", failure{message: "consumer group already exists", kind: errorx.KindConflict}},
+	{"OOM", failure{message: "out of memory", kind: errorx.KindUnavailable}},
+	{"NOAUTH", failure{message: "authentication required", kind: errorx.KindUnauthorized}},
+	{"WRONGPASS", failure{message: "wrong password", kind: errorx.KindUnauthorized}},
+	{"NOPERM", failure{message: "no permission", kind: errorx.KindForbidden}},
+	{"WRONGTYPE", failure{message: "wrong type", kind: errorx.KindInvalid}},
+	{"ERR max number of clients reached", failure{message: "max clients reached", kind: errorx.KindTooManyRequests, retryable: true}},
+}
+
+// Translate translates go-redis error to classified custom error with Type type.
+//
+// redis.Nil gets KindNotFound, timeouts - KindTimeout, cluster redirections and failures
+// (MOVED, ASK, CLUSTERDOWN, TRYAGAIN, LOADING) - KindUnavailable and are marked as retryable, BUSYGROUP - KindConflict.
+// Slot and node of MOVED/ASK redirections are stored in the context by SlotKey and NodeKey.
+//
+// Package does not depend on go-redis, errors are recognized by their text.
+// If error is not recognized - it is returned as is. If provided error is nil - return nil
+func Translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	classified, reply, ok := classify(err)
+	if !ok {
+		return err
+	}
+
+	translated := errorx.Get(errorx.Wrapped(Type, err, classified.message)).
+		SetKind(classified.kind)
+	if classified.retryable {
+		translated = translated.SetRetryable(true)
+	}
+
+	// "MOVED 3999 127.0.0.1:6381"
+	if parts := strings.Fields(reply); len(parts) == 3 && (parts[0] == "MOVED" || parts[0] == "ASK") {
+		if slot, e := strconv.Atoi(parts[1]); e == nil {
+			translated = translated.AddContext(SlotKey, slot)
+		}
+
+		translated = translated.AddContext(NodeKey, parts[2])
+	}
+
+	return translated
+}
+
+// classify searches redis error in the chain and classifies it. Returns reply text of the server error
+func classify(err error) (failure, string, bool) {
+	for current := err; current != nil; current = unwrap(current) {
+		text := current.Error()
+		if classified, ok := clientErrors[text]; ok {
+			return classified, text, true
+		}
+
+		for _, server := range serverErrors {
+			if matchReply(text, server.prefix) {
+				return server.failure, text, true
+			}
+		}
+	}
+
+	if errorx.IsTimeout(err) {
+		return failure{message: "timeout", kind: errorx.KindTimeout, retryable: true}, "", true
+	}
+
+	return failure{}, "", false
+}
+
+// matchReply checks if server reply starts with provided prefix (see serverErrors)
+func matchReply(reply, prefix string) bool {
+	if strings.Contains(prefix, " ") {
+		return strings.HasPrefix(reply, prefix)
+	}
+
+	code, _, _ := strings.Cut(reply, " ")
+	return code == prefix
+}
+
+// unwrap returns inner error (the first one for joined errors)
+func unwrap(err error) error {
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		return wrapped.Unwrap()
+	case interface{ Unwrap() []error }:
+		if inner := wrapped.Unwrap(); len(inner) > 0 {
+			return inner[0]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package redisx
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/boostgo/errorx"
+)
+
+// redisError mirrors proto.RedisError of go-redis (redis.Nil is the same type)
+type redisError string
+
+func (err redisError) Error() string { return string(err) }
+
+const redisNil = redisError("redis: nil")
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		message   string
+		kind      errorx.Kind
+		retryable bool
+		context   map[string]any
+	}{
+		{
+			name:    "nil reply",
+			err:     redisNil,
+			message: "key not found",
+			kind:    errorx.KindNotFound,
+		},
+		{
+			name:    "wrapped nil reply",
+			err:     fmt.Errorf("get session: %w", redisNil),
+			message: "key not found",
+			kind:    errorx.KindNotFound,
+		},
+		{
+			name:      "pool timeout",
+			err:       errors.New("redis: connection pool timeout"),
+			message:   "connection pool timeout",
+			kind:      errorx.KindTimeout,
+			retryable: true,
+		},
+		{
+			name:      "network timeout",
+			err:       fmt.Errorf("read: %w", os.ErrDeadlineExceeded),
+			message:   "timeout",
+			kind:      errorx.KindTimeout,
+			retryable: true,
+		},
+		{
+			name:      "moved slot",
+			err:       redisError("MOVED 3999 127.0.0.1:6381"),
+			message:   "slot moved",
+			kind:      errorx.KindUnavailable,
+			retryable: true,
+			context:   map[string]any{SlotKey: 3999, NodeKey: "127.0.0.1:6381"},
+		},
+		{
+			name:      "cluster down",
+			err:       redisError("CLUSTERDOWN The cluster is down"),
+			message:   "cluster down",
+			kind:      errorx.KindUnavailable,
+			retryable: true,
+		},
+		{
+			name:      "server busy",
+			err:       redisError("BUSY Redis is busy running a script"),
+			message:   "server busy",
+			kind:      errorx.KindUnavailable,
+			retryable: true,
+		},
+		{
+			name:    "busy group is not busy server",
+			err:     redisError("BUSYGROUP Consumer Group name already exists"),
+			message: "consumer group already exists",
+			kind:    errorx.KindConflict,
+		},
+		{
+			name:      "max clients",
+			err:       redisError("ERR max number of clients reached"),
+			message:   "max clients reached",
+			kind:      errorx.KindTooManyRequests,
+			retryable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			translated := Translate(tt.err)
+
+			custom, ok := errorx.TryGet(translated)
+			if !ok {
+				t.Fatalf("Translate() = %v, want custom error", translated)
+			}
+
+			if custom.Type(1) != Type || custom.Message(1) != tt.message {
+				t.Errorf("Translate() = [%s] %s, want [%s] %s", custom.Type(1), custom.Message(1), Type, tt.message)
+			}
+
+			if got := errorx.KindOf(translated); got != tt.kind {
+				t.Errorf("KindOf() = %q, want %q", got, tt.kind)
+			}
+
+			if got := errorx.IsRetryable(translated); got != tt.retryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.retryable)
+			}
+
+			for key, want := range tt.context {
+				if got, _ := errorx.ContextValue(translated, key); got != want {
+					t.Errorf("context %q = %v, want %v", key, got, want)
+				}
+			}
+
+			if !errors.Is(translated, tt.err) {
+				t.Errorf("translated error does not wrap original one")
+			}
+		})
+	}
+
+	t.Run("not recognized error", func(t *testing.T) {
+		for _, err := range []error{errors.New("oops"), redisError("ERR unknown command")} {
+			if got := Translate(err); got != err {
+				t.Errorf("Translate(%v) = %v, want error as is", err, got)
+			}
+		}
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		if got := Translate(nil); got != nil {
+			t.Errorf("Translate() = %v, want nil", got)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package errorx
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Reporter sends errors to the external error tracking system (Sentry, Rollbar, Bugsnag, Datadog, etc...)
+type Reporter interface {
+	Report(ctx context.Context, err *Error)
+}
+
+// ReporterFunc is function which implements Reporter interface
+type ReporterFunc func(ctx context.Context, err *Error)
+
+// Report calls the function
+func (fn ReporterFunc) Report(ctx context.Context, err *Error) {
+	fn(ctx, err)
+}
+
+var reporter atomic.Pointer[Reporter]
+
+// SetReporter sets global reporter which is used by Report. Use MultiReporter for reporting to several systems
+func SetReporter(r Reporter) {
+	if r == nil {
+		reporter.Store(nil)
+		return
+	}
+
+	reporter.Store(&r)
+}
+
+// Report sends error to the global reporter (see SetReporter).
+//
+// Built-in errors are wrapped by custom error. If error is nil or reporter is not set - nothing happens
+func Report(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	custom := promote(err)
+	publish(EventReport, custom)
+
+	r := reporter.Load()
+	if r == nil {
+		return
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	safeReport(ctx, *r, custom)
+}
+
+type multiReporter []Reporter
+
+// MultiReporter returns reporter which sends every error to all provided reporters.
+//
+// Panic inside one reporter does not affect others
+func MultiReporter(reporters ...Reporter) Reporter {
+	multi := make(multiReporter, 0, len(reporters))
+	for _, r := range reporters {
+		if r != nil {
+			multi = append(multi, r)
+		}
+	}
+
+	return multi
+}
+
+// Report sends error to all reporters
+func (multi multiReporter) Report(ctx context.Context, err *Error) {
+	for _, r := range multi {
+		safeReport(ctx, r, err)
+	}
+}
+
+// safeReport calls reporter and ignores its panic
+func safeReport(ctx context.Context, r Reporter, err *Error) {
+	defer func() {
+		_ = recover()
+	}()
+
+	r.Report(ctx, err)
+}
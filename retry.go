@@ -0,0 +1,155 @@
+package errorx
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	AttemptsKey = "attempts"
+
+	defaultMaxAttempts = 3
+)
+
+// Backoff returns delay before next attempt. Attempt starts from 1
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff returns backoff with the same delay before every attempt
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff returns backoff which doubles delay on every attempt: base, base*2, base*4 ... up to max.
+//
+// If max is zero or negative - delay is limited only by max duration (it never overflows)
+func ExponentialBackoff(base, max time.Duration) Backoff {
+	limit := max
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+
+	return func(attempt int) time.Duration {
+		if base <= 0 {
+			return 0
+		}
+
+		delay := min(base, limit)
+		for i := 1; i < attempt && delay < limit; i++ {
+			if delay > limit/2 {
+				return limit
+			}
+
+			delay *= 2
+		}
+
+		return delay
+	}
+}
+
+// WithJitter returns backoff which randomizes delay of provided backoff in range [delay/2, delay)
+func WithJitter(backoff Backoff) Backoff {
+	return func(attempt int) time.Duration {
+		delay := backoff(attempt)
+		if delay <= 1 {
+			return delay
+		}
+
+		half := delay / 2
+		return half + rand.N(delay-half)
+	}
+}
+
+// RetryPolicy describes how TryRetry retries failed function
+type RetryPolicy struct {
+	// MaxAttempts is maximum count of attempts (including the first one). Default is 3
+	MaxAttempts int
+	// Backoff returns delay before next attempt. Default is no delay.
+	// If error has RetryAfter metadata which is greater than backoff delay - RetryAfter is used
+	Backoff Backoff
+	// Retryable decides if error could be retried. Default is IsRetryable
+	// (retryable flag, kind and RetryAfter metadata of the error), so permanent errors are never retried
+	Retryable func(error) bool
+}
+
+// TryRetry runs provided function (with panic recovery like Try) and retries it on retryable errors by provided policy.
+//
+// Final error is the last error with count of attempts in the context by AttemptsKey
+func TryRetry(fn func() error, policy RetryPolicy) error {
+	return TryRetryContext(context.Background(), func(context.Context) error {
+		return fn()
+	}, policy)
+}
+
+// TryRetryContext is like TryRetry but provided function has context as an argument.
+//
+// Retrying stops when context is canceled
+func TryRetryContext(ctx context.Context, fn func(ctx context.Context) error, policy RetryPolicy) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+
+	var (
+		err     error
+		attempt int
+	)
+	for attempt = 1; attempt <= maxAttempts; attempt++ {
+		if err = TryContext(ctx, fn); err == nil {
+			return nil
+		}
+
+		if attempt == maxAttempts || !retryable(err) || ctx.Err() != nil {
+			break
+		}
+
+		if !sleep(ctx, retryDelay(policy.Backoff, attempt, err)) {
+			break
+		}
+	}
+
+	return detach(err).AddContext(AttemptsKey, attempt)
+}
+
+// retryDelay returns delay before next attempt by backoff and RetryAfter metadata of the error
+func retryDelay(backoff Backoff, attempt int, err error) time.Duration {
+	var delay time.Duration
+	if backoff != nil {
+		delay = backoff(attempt)
+	}
+
+	if retryAfter, ok := RetryAfter(err); ok && retryAfter > delay {
+		delay = retryAfter
+	}
+
+	return delay
+}
+
+// sleep waits provided delay. Returns false if context was canceled while waiting
+func sleep(ctx context.Context, delay time.Duration) bool {
+	if delay <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
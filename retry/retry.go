@@ -0,0 +1,55 @@
+// Package retry provides retry executor driven by errorx error classification.
+//
+//	err := retry.Do(ctx, func(ctx context.Context) error {
+//		return client.Send(ctx, request)
+//	}, retry.Max(5), retry.Backoff(errorx.ExponentialBackoff(100*time.Millisecond, 5*time.Second)))
+package retry
+
+import (
+	"context"
+
+	"github.com/boostgo/errorx"
+)
+
+// Option configures Do
+type Option func(policy *errorx.RetryPolicy)
+
+// On sets predicate which decides if error could be retried. Default is errorx.IsRetryable
+// (retryable flag, kind and RetryAfter metadata of the error)
+func On(pred func(error) bool) Option {
+	return func(policy *errorx.RetryPolicy) {
+		policy.Retryable = pred
+	}
+}
+
+// Max sets maximum count of attempts (including the first one). Default is 3
+func Max(attempts int) Option {
+	return func(policy *errorx.RetryPolicy) {
+		policy.MaxAttempts = attempts
+	}
+}
+
+// Backoff sets delay before next attempt. Default is no delay.
+//
+// If error has RetryAfter metadata which is greater than backoff delay - RetryAfter is used
+func Backoff(backoff errorx.Backoff) Option {
+	return func(policy *errorx.RetryPolicy) {
+		policy.Backoff = backoff
+	}
+}
+
+// Do runs provided function (with panic recovery) and retries it while error is retryable,
+// attempts are not exhausted and context is not canceled.
+//
+// Do is option-based form of errorx.TryRetryContext: returned error is the last error
+// with count of attempts in the context by errorx.AttemptsKey
+func Do(ctx context.Context, fn func(ctx context.Context) error, opts ...Option) error {
+	policy := errorx.RetryPolicy{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&policy)
+		}
+	}
+
+	return errorx.TryRetryContext(ctx, fn, policy)
+}
@@ -0,0 +1,118 @@
+package errorx
+
+import "time"
+
+// retryState describes if operation failed with error could be retried
+type retryState int8
+
+const (
+	retryUnknown retryState = iota
+	retryAllowed
+	retryForbidden
+)
+
+// SetRetryable marks error as retryable (temporary) or permanent one
+func (err *Error) SetRetryable(retryable bool) *Error {
+	err = err.derive()
+	if retryable {
+		err.retry = retryAllowed
+	} else {
+		err.retry = retryForbidden
+	}
+
+	return err
+}
+
+// Permanent returns copy of provided error marked as permanent, so retry loops stop on it
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return detach(err).SetRetryable(false)
+}
+
+// Retryable returns copy of provided error marked as retryable
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return detach(err).SetRetryable(true)
+}
+
+// IsRetryable checks if error was marked as retryable.
+//
+// The first custom error in the chain which was marked (retryable or permanent) decides.
+// If error was not marked, it is retryable if its kind is KindUnavailable, KindTimeout or KindTooManyRequests
+// or it has RetryAfter metadata
+func IsRetryable(err error) bool {
+	switch retryStateOf(err) {
+	case retryAllowed:
+		return true
+	case retryForbidden:
+		return false
+	}
+
+	if _, ok := RetryAfter(err); ok {
+		return true
+	}
+
+	switch KindOf(err) {
+	case KindUnavailable, KindTimeout, KindTooManyRequests:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPermanent checks if error was marked as permanent.
+//
+// The first custom error in the chain which was marked (retryable or permanent) decides
+func IsPermanent(err error) bool {
+	return retryStateOf(err) == retryForbidden
+}
+
+// retryStateOf returns first known retry state in the chain of custom errors
+func retryStateOf(err error) retryState {
+	state := retryUnknown
+	walkChain(err, func(err error) bool {
+		custom, ok := err.(*Error)
+		if !ok {
+			return true
+		}
+
+		state = custom.retry
+		return state == retryUnknown
+	})
+
+	return state
+}
+
+// SetRetryAfter sets minimal delay before retry (like "Retry-After" HTTP header) and marks error as retryable
+func (err *Error) SetRetryAfter(delay time.Duration) *Error {
+	err = err.derive()
+	err.retryAfter = delay
+	err.retry = retryAllowed
+	return err
+}
+
+// RetryAfter returns first retry delay found in the chain of custom errors (see SetRetryAfter)
+func RetryAfter(err error) (time.Duration, bool) {
+	var (
+		delay time.Duration
+		found bool
+	)
+
+	walkChain(err, func(err error) bool {
+		custom, ok := err.(*Error)
+		if !ok {
+			return true
+		}
+
+		delay, found = custom.retryAfter, custom.retryAfter > 0
+		return !found
+	})
+
+	return delay, found
+}
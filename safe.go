@@ -0,0 +1,100 @@
+package errorx
+
+import "sync/atomic"
+
+// safeMessage is message of the sanitized built-in error (see Safe)
+const safeMessage = "internal error"
+
+var safeKeys atomic.Pointer[map[string]struct{}]
+
+// SetSafeKeys sets whitelist of context keys which could be exposed to external clients by Safe().
+//
+// Calling without keys means no context keys are exposed (default)
+func SetSafeKeys(keys ...string) {
+	if len(keys) == 0 {
+		safeKeys.Store(nil)
+		return
+	}
+
+	set := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		set[key] = struct{}{}
+	}
+
+	safeKeys.Store(&set)
+}
+
+// Safe returns sanitized copy of the error which could be handed to external clients or third-party webhooks.
+//
+// Copy contains only messages, code, kind, user message and whitelisted context keys (see SetSafeKeys).
+// Types, trace, tags and suppressed errors are stripped. Inner custom errors are sanitized too,
+// built-in inner errors are dropped because their text could contain internal details.
+//
+// If there is no custom error in the chain - return generic "internal error" with KindInternal,
+// because text of built-in error could contain internal details. If provided error is nil - return nil
+func Safe(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	custom, ok := TryGet(err)
+	if !ok {
+		generic := newMessage(safeMessage)
+		generic.kind = KindInternal
+		return generic
+	}
+
+	safe := &Error{
+		code:        custom.code,
+		kind:        custom.kind,
+		createdAt:   custom.createdAt,
+		retryAfter:  custom.retryAfter,
+		userMessage: custom.userMessage,
+		separator:   custom.separator,
+		order:       custom.order,
+		id:          custom.id,
+	}
+	safe.levels = make([]level, 0, len(custom.levels))
+	for _, l := range custom.levels {
+		safe.levels = append(safe.levels, level{message: l.message})
+	}
+
+	if set := safeKeys.Load(); set != nil {
+		for _, key := range custom.contextOrder() {
+			if _, whitelisted := (*set)[key]; whitelisted {
+				value, _ := custom.contextValue(key)
+				safe.setContextValue(key, value)
+			}
+		}
+	}
+
+	if custom.innerError != nil {
+		safe.innerError = safeInner(custom.innerError)
+	}
+
+	return safe
+}
+
+// safeInner sanitizes inner error: custom errors by Safe(), join/multi errors by every sub-error
+func safeInner(err error) error {
+	if isMultiError(err) {
+		inner := make([]error, 0)
+		for _, sub := range innerErrors(err) {
+			if safe := safeInner(sub); safe != nil {
+				inner = append(inner, safe)
+			}
+		}
+
+		if len(inner) == 0 {
+			return nil
+		}
+
+		return Join(inner...)
+	}
+
+	if _, ok := TryGet(err); !ok {
+		return nil
+	}
+
+	return Safe(err)
+}
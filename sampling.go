@@ -0,0 +1,145 @@
+package errorx
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// maxSamplingStates is count of tracked fingerprints after which expired states are cleaned up
+	maxSamplingStates = 10_000
+	// samplingEvictions is count of the least recently seen states which are evicted if cleanup freed nothing
+	samplingEvictions = maxSamplingStates / 10
+)
+
+// SamplingPolicy describes how often errors with the same fingerprint (see Fingerprint) are reported
+type SamplingPolicy struct {
+	// Rate means report 1-in-Rate errors of every fingerprint. Zero or 1 means report all errors
+	Rate int
+	// Limit is max count of reports of every fingerprint per Interval. Zero means no limit
+	Limit int
+	// Interval is rate limiting window. Default is 1 minute
+	Interval time.Duration
+}
+
+type samplingState struct {
+	seen        int
+	skipped     int
+	windowStart time.Time
+	windowCount int
+	lastSeen    time.Time
+}
+
+type samplingReporter struct {
+	next   Reporter
+	policy SamplingPolicy
+
+	mx     sync.Mutex
+	states map[string]*samplingState
+}
+
+// SampledReporter returns reporter which samples and rate limits errors by fingerprint before sending them to the next reporter,
+// so error storm does not overload error tracking system:
+//
+//	errorx.SetReporter(errorx.SampledReporter(sentryReporter, errorx.SamplingPolicy{
+//		Rate:     10,
+//		Limit:    100,
+//		Interval: time.Minute,
+//	}))
+//
+// Reported error is annotated by OccurrencesKey context with count of occurrences since previous report
+func SampledReporter(next Reporter, policy SamplingPolicy) Reporter {
+	if policy.Interval <= 0 {
+		policy.Interval = time.Minute
+	}
+
+	return &samplingReporter{
+		next:   next,
+		policy: policy,
+		states: make(map[string]*samplingState),
+	}
+}
+
+// Report sends error to the next reporter if it passes sampling and rate limiting
+func (r *samplingReporter) Report(ctx context.Context, err *Error) {
+	if r.next == nil || err == nil {
+		return
+	}
+
+	occurrences, ok := r.allow(Fingerprint(err), time.Now())
+	if !ok {
+		return
+	}
+
+	if occurrences > 1 {
+		err = err.AddContext(OccurrencesKey, occurrences)
+	}
+
+	r.next.Report(ctx, err)
+}
+
+// allow decides if error with provided fingerprint must be reported. Returns count of occurrences since previous report
+func (r *samplingReporter) allow(fingerprint string, now time.Time) (int, bool) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	state, ok := r.states[fingerprint]
+	if !ok {
+		if len(r.states) >= maxSamplingStates {
+			r.cleanup(now)
+		}
+
+		state = &samplingState{windowStart: now}
+		r.states[fingerprint] = state
+	}
+
+	if now.Sub(state.windowStart) >= r.policy.Interval {
+		state.windowStart = now
+		state.windowCount = 0
+	}
+
+	state.seen++
+	state.lastSeen = now
+	if r.policy.Rate > 1 && (state.seen-1)%r.policy.Rate != 0 {
+		state.skipped++
+		return 0, false
+	}
+
+	if r.policy.Limit > 0 && state.windowCount >= r.policy.Limit {
+		state.skipped++
+		return 0, false
+	}
+
+	state.windowCount++
+	occurrences := state.skipped + 1
+	state.skipped = 0
+	return occurrences, true
+}
+
+// cleanup removes states which window is expired.
+//
+// If all states are fresh (nothing was removed) - evicts the least recently seen states,
+// so count of tracked fingerprints stays bounded during error storm with many unique fingerprints
+func (r *samplingReporter) cleanup(now time.Time) {
+	for fingerprint, state := range r.states {
+		if now.Sub(state.windowStart) >= r.policy.Interval {
+			delete(r.states, fingerprint)
+		}
+	}
+
+	if len(r.states) < maxSamplingStates {
+		return
+	}
+
+	fingerprints := slices.Collect(maps.Keys(r.states))
+	slices.SortFunc(fingerprints, func(a, b string) int {
+		return r.states[a].lastSeen.Compare(r.states[b].lastSeen)
+	})
+
+	for _, fingerprint := range fingerprints[:samplingEvictions] {
+		delete(r.states, fingerprint)
+	}
+}
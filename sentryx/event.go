@@ -0,0 +1,234 @@
+package sentryx
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/boostgo/errorx"
+)
+
+// Event mirrors sentry.Event (github.com/getsentry/sentry-go) fields used for error reporting.
+//
+// Fields have the same names and JSON tags, so event could be copied field by field into sentry.Event
+// or sent as JSON payload directly
+type Event struct {
+	Level       string            `json:"level,omitempty"`
+	Message     string            `json:"message,omitempty"`
+	Fingerprint []string          `json:"fingerprint,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Extra       map[string]any    `json:"extra,omitempty"`
+	Exception   []Exception       `json:"exception,omitempty"`
+	Timestamp   time.Time         `json:"timestamp"`
+}
+
+// Exception mirrors sentry.Exception
+type Exception struct {
+	Type       string      `json:"type,omitempty"`
+	Value      string      `json:"value,omitempty"`
+	Module     string      `json:"module,omitempty"`
+	Stacktrace *Stacktrace `json:"stacktrace,omitempty"`
+}
+
+// Stacktrace mirrors sentry.Stacktrace
+type Stacktrace struct {
+	Frames []Frame `json:"frames,omitempty"`
+}
+
+// Frame mirrors sentry.Frame
+type Frame struct {
+	Function string `json:"function,omitempty"`
+	Module   string `json:"module,omitempty"`
+	Filename string `json:"filename,omitempty"`
+	AbsPath  string `json:"abs_path,omitempty"`
+	Lineno   int    `json:"lineno,omitempty"`
+	InApp    bool   `json:"in_app"`
+}
+
+const (
+	LevelError = "error"
+)
+
+// NewEvent converts error to event with fields of Sentry event.
+//
+// Package does not depend on Sentry SDK, so event is not *sentry.Event and must be copied into it
+// by small adapter on the caller side:
+//
+//	func toSentry(event *sentryx.Event) *sentry.Event {
+//		converted := sentry.NewEvent()
+//		converted.Level = sentry.Level(event.Level)
+//		converted.Message = event.Message
+//		converted.Fingerprint = event.Fingerprint
+//		converted.Tags = event.Tags
+//		converted.Extra = event.Extra
+//		converted.Timestamp = event.Timestamp
+//		for _, exception := range event.Exception {
+//			copied := sentry.Exception{Type: exception.Type, Value: exception.Value, Module: exception.Module}
+//			if exception.Stacktrace != nil {
+//				copied.Stacktrace = &sentry.Stacktrace{}
+//				for _, frame := range exception.Stacktrace.Frames {
+//					copied.Stacktrace.Frames = append(copied.Stacktrace.Frames, sentry.Frame{
+//						Function: frame.Function, Module: frame.Module, Filename: frame.Filename,
+//						AbsPath: frame.AbsPath, Lineno: frame.Lineno, InApp: frame.InApp,
+//					})
+//				}
+//			}
+//			converted.Exception = append(converted.Exception, copied)
+//		}
+//		return converted
+//	}
+//
+//	sentry.CaptureEvent(toSentry(sentryx.NewEvent(err)))
+//
+// Every wrap level of the chain (see errorx.Walk) becomes exception (inner first, like Sentry expects),
+// stack frames are attached to the level where custom error was created,
+// fingerprint is errorx.Fingerprint, tags are tags of the error chain plus kind and code,
+// extra data is redacted context of the error chain.
+//
+// If provided error is nil - return nil
+func NewEvent(err error) *Event {
+	if err == nil {
+		return nil
+	}
+
+	event := &Event{
+		Level:       LevelError,
+		Message:     err.Error(),
+		Fingerprint: []string{errorx.Fingerprint(err)},
+		Tags:        make(map[string]string),
+		Extra:       make(map[string]any),
+		Exception:   newExceptions(err),
+		Timestamp:   time.Now(),
+	}
+
+	if custom, ok := errorx.TryGet(err); ok {
+		event.Message = custom.Message()
+		event.Timestamp = custom.CreatedAt()
+	}
+
+	if kind := errorx.KindOf(err); kind != errorx.KindUnknown {
+		event.Tags["kind"] = kind.String()
+	}
+
+	if code := errorx.CodeOf(err); code != "" {
+		event.Tags["code"] = code.String()
+	}
+
+	for _, tag := range errorx.TagsOf(err) {
+		event.Tags[tag] = "true"
+	}
+
+	var last error
+	errorx.Walk(err, func(layer errorx.Layer) bool {
+		custom, ok := layer.Err.(*errorx.Error)
+		if !ok || layer.Err == last {
+			return true
+		}
+		last = layer.Err
+
+		for key, value := range custom.Redacted().Context() {
+			if _, exist := event.Extra[key]; !exist {
+				event.Extra[key] = value
+			}
+		}
+
+		return true
+	})
+
+	return event
+}
+
+// newExceptions converts wrap levels of the error chain (see errorx.Walk) to exceptions (from inner level to outer one).
+//
+// Stack frames of custom error are attached to its inner level, where error was created
+func newExceptions(err error) []Exception {
+	layers := make([]errorx.Layer, 0)
+	errorx.Walk(err, func(layer errorx.Layer) bool {
+		layers = append(layers, layer)
+		return true
+	})
+
+	exceptions := make([]Exception, 0, len(layers))
+	for i, layer := range layers {
+		innermost := i == len(layers)-1 || layers[i+1].Err != layer.Err
+		exceptions = append(exceptions, newException(layer, innermost))
+	}
+
+	slices.Reverse(exceptions)
+	return exceptions
+}
+
+// newException converts one wrap level to exception. Stack frames are attached only if level is innermost for its error
+func newException(layer errorx.Layer, innermost bool) Exception {
+	custom, ok := layer.Err.(*errorx.Error)
+	if !ok {
+		return Exception{
+			Type:  fmt.Sprintf("%T", layer.Err),
+			Value: layer.Message,
+		}
+	}
+
+	exception := Exception{
+		Type:  layer.Type,
+		Value: layer.Message,
+	}
+
+	if exception.Type == "" {
+		exception.Type = "errorx.Error"
+	}
+
+	if !innermost {
+		return exception
+	}
+
+	if frames := custom.Frames(); len(frames) > 0 {
+		exception.Stacktrace = newStacktrace(frames)
+	}
+
+	return exception
+}
+
+// newStacktrace converts frames to Sentry stacktrace. Sentry expects the oldest frame first
+func newStacktrace(frames []errorx.Frame) *Stacktrace {
+	stacktrace := &Stacktrace{
+		Frames: make([]Frame, 0, len(frames)),
+	}
+
+	for i := len(frames) - 1; i >= 0; i-- {
+		module, function := splitFunction(frames[i].Function)
+		stacktrace.Frames = append(stacktrace.Frames, Frame{
+			Function: function,
+			Module:   module,
+			Filename: path.Base(frames[i].File),
+			AbsPath:  frames[i].File,
+			Lineno:   frames[i].Line,
+			InApp:    !isSystemModule(module),
+		})
+	}
+
+	return stacktrace
+}
+
+// splitFunction splits "github.com/org/pkg.(*T).Method" into module "github.com/org/pkg" and function "(*T).Method"
+func splitFunction(name string) (string, string) {
+	slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[slash+1:], ".")
+	if dot < 0 {
+		return "", name
+	}
+
+	dot += slash + 1
+	return name[:dot], name[dot+1:]
+}
+
+// isSystemModule returns true for standard library modules and errorx itself
+func isSystemModule(module string) bool {
+	if module == "" || module == "main" {
+		return false
+	}
+
+	first, _, _ := strings.Cut(module, "/")
+	return !strings.Contains(first, ".") || strings.HasPrefix(module, "github.com/boostgo/errorx")
+}
@@ -0,0 +1,84 @@
+package errorx
+
+import "errors"
+
+// SingleError is adapter of the error chain for libraries which understand only single-unwrap convention (Unwrap() error).
+//
+// Unwrap returns primary inner error (the first one of joined errors), while Is and As still search the whole original chain
+type SingleError struct {
+	err error
+}
+
+// Single returns adapter of provided error with Unwrap() error method (see SingleError).
+//
+// Errors which already follow single-unwrap convention (or have no inner errors) are returned as is.
+// If provided error is nil - return nil
+func Single(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch err.(type) {
+	case *SingleError:
+		return err
+	case *Error:
+		return &SingleError{err: err}
+	}
+
+	if _, ok := aggregated(err); ok {
+		return &SingleError{err: err}
+	}
+
+	return err
+}
+
+// Error returns text of the original error
+func (s *SingleError) Error() string {
+	return s.err.Error()
+}
+
+// Unwrap returns primary inner error adapted to single-unwrap convention
+func (s *SingleError) Unwrap() error {
+	var inner error
+	if custom, ok := s.err.(*Error); ok {
+		if !custom.selfWrapped() {
+			inner = custom.innerError
+		}
+	} else {
+		inner = primary(s.err)
+	}
+
+	// joined errors are containers, so their primary error is returned
+	for inner != nil && isMultiError(inner) {
+		inner = primary(inner)
+	}
+
+	return Single(inner)
+}
+
+// Is searches target in the whole original chain (see errorx.Is)
+func (s *SingleError) Is(target error) bool {
+	return Is(s.err, target)
+}
+
+// As searches target in the whole original chain (see errors.As)
+func (s *SingleError) As(target any) bool {
+	return errors.As(s.err, target)
+}
+
+// Original returns adapted error
+func (s *SingleError) Original() error {
+	return s.err
+}
+
+// primary returns the first not nil error of joined errors
+func primary(err error) error {
+	errs, _ := aggregated(err)
+	for _, inner := range errs {
+		if inner != nil {
+			return inner
+		}
+	}
+
+	return nil
+}
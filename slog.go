@@ -0,0 +1,40 @@
+package errorx
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer, so error logged by slog is written as group of structured fields:
+// type, message, code, kind, context (sensitive values are masked, see SetRedactedKeys) and inner error:
+//
+//	slog.Error("get user failed", "error", err)
+func (err *Error) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, 6)
+	if errType := err.Type(); errType != "" {
+		attrs = append(attrs, slog.String("type", errType))
+	}
+
+	attrs = append(attrs, slog.String("message", err.Message()))
+
+	if err.code != "" {
+		attrs = append(attrs, slog.String("code", err.code.String()))
+	}
+
+	if err.kind != KindUnknown {
+		attrs = append(attrs, slog.String("kind", err.kind.String()))
+	}
+
+	if err.contextLen() > 0 {
+		context := redactContext(err.contextMap())
+		fields := make([]any, 0, len(context))
+		for _, key := range err.contextOrder() {
+			fields = append(fields, slog.Any(key, context[key]))
+		}
+
+		attrs = append(attrs, slog.Group("context", fields...))
+	}
+
+	if err.innerError != nil && !err.cyclic() {
+		attrs = append(attrs, slog.Any("inner", err.innerError))
+	}
+
+	return slog.GroupValue(attrs...)
+}
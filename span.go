@@ -0,0 +1,120 @@
+package errorx
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+const (
+	SpanIDKey = "span_id"
+)
+
+// SpanExtractor extracts current trace ID and span ID from the context (OpenTelemetry, OpenTracing, etc...)
+type SpanExtractor func(ctx context.Context) (traceID, spanID string)
+
+var spanExtractor atomic.Pointer[SpanExtractor]
+
+// SetSpanExtractor sets function which extracts trace ID and span ID from the context for NewCtx and WrapCtx.
+//
+// Package does not depend on OpenTelemetry, so OpenTelemetry spans are extracted only after
+// extractor is set (see OTelSpanExtractor):
+//
+//	errorx.SetSpanExtractor(errorx.OTelSpanExtractor(trace.SpanContextFromContext))
+//
+// By default only IDs attached by ContextWithSpan are extracted
+func SetSpanExtractor(extractor SpanExtractor) {
+	if extractor == nil {
+		spanExtractor.Store(nil)
+		return
+	}
+
+	spanExtractor.Store(&extractor)
+}
+
+// SpanContext is the part of OpenTelemetry trace.SpanContext which is used by OTelSpanExtractor
+type SpanContext[TraceID, SpanID fmt.Stringer] interface {
+	IsValid() bool
+	TraceID() TraceID
+	SpanID() SpanID
+}
+
+// OTelSpanExtractor adapts OpenTelemetry trace.SpanContextFromContext to SpanExtractor without importing OpenTelemetry:
+//
+//	errorx.SetSpanExtractor(errorx.OTelSpanExtractor(trace.SpanContextFromContext))
+//
+// Invalid span context (context without span) gives empty IDs
+func OTelSpanExtractor[S SpanContext[T, P], T, P fmt.Stringer](fromContext func(ctx context.Context) S) SpanExtractor {
+	return func(ctx context.Context) (string, string) {
+		span := fromContext(ctx)
+		if !span.IsValid() {
+			return "", ""
+		}
+
+		return span.TraceID().String(), span.SpanID().String()
+	}
+}
+
+type spanContextKey struct{}
+
+type spanIDs struct {
+	traceID string
+	spanID  string
+}
+
+// ContextWithSpan returns context with trace ID and span ID which are extracted by default span extractor
+func ContextWithSpan(ctx context.Context, traceID, spanID string) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, spanIDs{
+		traceID: traceID,
+		spanID:  spanID,
+	})
+}
+
+// NewCtx creates new Error object with provided message and trace/span IDs extracted from the context
+// by span extractor (stored by TraceIDKey and SpanIDKey). See SetSpanExtractor for OpenTelemetry
+func NewCtx(ctx context.Context, message string) *Error {
+	return withSpan(ctx, New(message))
+}
+
+// WrapCtx works like Wrap and attaches trace/span IDs extracted from the context by span extractor
+// (stored by TraceIDKey and SpanIDKey). See SetSpanExtractor for OpenTelemetry
+func WrapCtx(ctx context.Context, errType string, err *error, message string, ctxMap ...map[string]any) {
+	if err == nil || *err == nil {
+		return
+	}
+
+	Wrap(errType, err, message, ctxMap...)
+	*err = withSpan(ctx, Get(*err))
+}
+
+// withSpan attaches trace/span IDs extracted from the context to the error
+func withSpan(ctx context.Context, err *Error) *Error {
+	traceID, spanID := extractSpan(ctx)
+	if traceID == "" && spanID == "" {
+		return err
+	}
+
+	if traceID != "" {
+		err = err.AddContext(TraceIDKey, traceID)
+	}
+
+	if spanID != "" {
+		err = err.AddContext(SpanIDKey, spanID)
+	}
+
+	return err
+}
+
+// extractSpan extracts trace/span IDs by span extractor (or default one)
+func extractSpan(ctx context.Context) (string, string) {
+	if ctx == nil {
+		return "", ""
+	}
+
+	if extractor := spanExtractor.Load(); extractor != nil {
+		return (*extractor)(ctx)
+	}
+
+	ids, _ := ctx.Value(spanContextKey{}).(spanIDs)
+	return ids.traceID, ids.spanID
+}
@@ -0,0 +1,94 @@
+package errorx
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// otelTraceID, otelSpanID and otelSpanContext mirror OpenTelemetry trace types used by OTelSpanExtractor
+type otelTraceID [16]byte
+
+func (id otelTraceID) String() string { return hex.EncodeToString(id[:]) }
+
+type otelSpanID [8]byte
+
+func (id otelSpanID) String() string { return hex.EncodeToString(id[:]) }
+
+type otelSpanContext struct {
+	traceID otelTraceID
+	spanID  otelSpanID
+}
+
+func (span otelSpanContext) IsValid() bool {
+	return span.traceID != otelTraceID{} && span.spanID != otelSpanID{}
+}
+
+func (span otelSpanContext) TraceID() otelTraceID { return span.traceID }
+
+func (span otelSpanContext) SpanID() otelSpanID { return span.spanID }
+
+type otelContextKey struct{}
+
+func otelSpanContextFromContext(ctx context.Context) otelSpanContext {
+	span, _ := ctx.Value(otelContextKey{}).(otelSpanContext)
+	return span
+}
+
+func TestWrapCtx(t *testing.T) {
+	span := otelSpanContext{
+		traceID: otelTraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		spanID:  otelSpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	}
+
+	tests := []struct {
+		name      string
+		extractor SpanExtractor
+		ctx       context.Context
+		traceID   string
+		spanID    string
+	}{
+		{
+			name:    "default extractor reads ContextWithSpan",
+			ctx:     ContextWithSpan(context.Background(), "trace", "span"),
+			traceID: "trace",
+			spanID:  "span",
+		},
+		{
+			name: "default extractor ignores OpenTelemetry span",
+			ctx:  context.WithValue(context.Background(), otelContextKey{}, span),
+		},
+		{
+			name:      "OpenTelemetry extractor",
+			extractor: OTelSpanExtractor(otelSpanContextFromContext),
+			ctx:       context.WithValue(context.Background(), otelContextKey{}, span),
+			traceID:   "4bf92f3577b34da6a3ce929d0e0e4736",
+			spanID:    "00f067aa0ba902b7",
+		},
+		{
+			name:      "OpenTelemetry extractor without span",
+			extractor: OTelSpanExtractor(otelSpanContextFromContext),
+			ctx:       context.Background(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetSpanExtractor(tt.extractor)
+			defer SetSpanExtractor(nil)
+
+			err := errors.New("no rows")
+			WrapCtx(tt.ctx, "Repo", &err, "select")
+
+			values := Get(err).Context()
+			if got, _ := values[TraceIDKey].(string); got != tt.traceID {
+				t.Errorf("trace ID = %q, want %q", got, tt.traceID)
+			}
+
+			if got, _ := values[SpanIDKey].(string); got != tt.spanID {
+				t.Errorf("span ID = %q, want %q", got, tt.spanID)
+			}
+		})
+	}
+}
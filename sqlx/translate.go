@@ -0,0 +1,206 @@
+package sqlx
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/boostgo/errorx"
+)
+
+const (
+	// Type is type of errors translated by TranslateSQL
+	Type = "SQL"
+
+	SQLStateKey   = "sql_state"
+	ConstraintKey = "constraint"
+)
+
+// failure is classification of the driver error
+type failure struct {
+	message   string
+	kind      errorx.Kind
+	retryable bool
+}
+
+var (
+	uniqueViolation      = failure{message: "unique violation", kind: errorx.KindConflict}
+	foreignKeyViolation  = failure{message: "foreign key violation", kind: errorx.KindInvalid}
+	notNullViolation     = failure{message: "not null violation", kind: errorx.KindInvalid}
+	checkViolation       = failure{message: "check violation", kind: errorx.KindInvalid}
+	serializationFailure = failure{message: "serialization failure", kind: errorx.KindConflict, retryable: true}
+	deadlock             = failure{message: "deadlock detected", kind: errorx.KindConflict, retryable: true}
+	lockTimeout          = failure{message: "lock timeout", kind: errorx.KindTimeout, retryable: true}
+	connectionLoss       = failure{message: "connection loss", kind: errorx.KindUnavailable, retryable: true}
+	queryCanceled        = failure{message: "query canceled", kind: errorx.KindCanceled}
+)
+
+// sqlStates classifies SQLSTATE codes (PostgreSQL and standard ones)
+var sqlStates = map[string]failure{
+	"23505": uniqueViolation,
+	"23503": foreignKeyViolation,
+	"23502": notNullViolation,
+	"23514": checkViolation,
+	"40001": serializationFailure,
+	"40P01": deadlock,
+	"55P03": lockTimeout,
+	"57014": queryCanceled,
+	"57P01": connectionLoss,
+	"57P02": connectionLoss,
+	"57P03": connectionLoss,
+}
+
+// mysqlNumbers classifies MySQL error numbers
+var mysqlNumbers = map[uint64]failure{
+	1062: uniqueViolation,
+	1451: foreignKeyViolation,
+	1452: foreignKeyViolation,
+	1048: notNullViolation,
+	3819: checkViolation,
+	1213: deadlock,
+	1205: lockTimeout,
+	1317: queryCanceled,
+	2006: connectionLoss,
+	2013: connectionLoss,
+}
+
+// TranslateSQL translates SQL driver error to classified custom error.
+//
+// Drivers are recognized without importing them: pq and pgx errors by SQLState() method
+// (and "Code" field), mysql errors by "Number" field, driver.ErrBadConn as connection loss.
+// Unique violations get KindConflict, foreign key/not null/check violations - KindInvalid,
+// serialization failures, deadlocks and connection losses are marked as retryable.
+// Constraint name (if driver provides it) is stored in the context by ConstraintKey, SQLSTATE - by SQLStateKey.
+//
+// If error is not recognized - it is returned as is. If provided error is nil - return nil
+func TranslateSQL(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	classified, state, constraint, ok := classify(err)
+	if !ok {
+		return err
+	}
+
+	translated := errorx.Get(errorx.Wrapped(Type, err, classified.message)).
+		SetKind(classified.kind)
+	if classified.retryable {
+		translated = translated.SetRetryable(true)
+	}
+
+	if state != "" {
+		translated = translated.AddContext(SQLStateKey, state)
+	}
+
+	if constraint != "" {
+		translated = translated.AddContext(ConstraintKey, constraint)
+	}
+
+	return translated
+}
+
+// classify searches driver error in the chain and classifies it
+func classify(err error) (classified failure, state, constraint string, ok bool) {
+	if errors.Is(err, driver.ErrBadConn) {
+		return connectionLoss, "", "", true
+	}
+
+	var stated interface{ SQLState() string }
+	if errors.As(err, &stated) {
+		state = stated.SQLState()
+		constraint = stringField(stated, "ConstraintName", "Constraint")
+	} else if holder, code, found := findField(err, "Code"); found && code.Kind() == reflect.String {
+		// older pq versions have no SQLState() method
+		state = code.String()
+		constraint = stringField(holder, "Constraint")
+	}
+
+	if _, number, found := findField(err, "Number"); found && number.CanUint() {
+		if classified, ok = mysqlNumbers[number.Uint()]; ok {
+			return classified, state, constraint, true
+		}
+	}
+
+	if state == "" {
+		return failure{}, "", "", false
+	}
+
+	if classified, ok = sqlStates[state]; ok {
+		return classified, state, constraint, true
+	}
+
+	// class 08 - connection exception
+	if strings.HasPrefix(state, "08") {
+		return connectionLoss, state, constraint, true
+	}
+
+	return failure{}, "", "", false
+}
+
+// maxChainDepth limits traversal of the error chain, so cyclic chains do not loop forever
+const maxChainDepth = 1024
+
+// findField searches struct field by name in the chain of errors (joined errors and custom errors included).
+// Returns error which has the field
+func findField(err error, name string) (error, reflect.Value, bool) {
+	return findFieldDepth(err, name, 0)
+}
+
+func findFieldDepth(err error, name string, depth int) (error, reflect.Value, bool) {
+	if err == nil || depth >= maxChainDepth {
+		return nil, reflect.Value{}, false
+	}
+
+	if value, ok := field(err, name); ok {
+		return err, value, true
+	}
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		return findFieldDepth(wrapped.Unwrap(), name, depth+1)
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapped.Unwrap() {
+			if holder, value, ok := findFieldDepth(inner, name, depth+1); ok {
+				return holder, value, true
+			}
+		}
+	}
+
+	return nil, reflect.Value{}, false
+}
+
+// stringField returns the first found not empty string field of the value
+func stringField(value any, names ...string) string {
+	for _, name := range names {
+		if found, ok := field(value, name); ok && found.Kind() == reflect.String && found.String() != "" {
+			return found.String()
+		}
+	}
+
+	return ""
+}
+
+// field returns exported field of the struct (or pointer to struct) by name
+func field(value any, name string) (reflect.Value, bool) {
+	reflected := reflect.ValueOf(value)
+	for reflected.Kind() == reflect.Pointer {
+		if reflected.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		reflected = reflected.Elem()
+	}
+
+	if reflected.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	found := reflected.FieldByName(name)
+	if !found.IsValid() || !found.CanInterface() {
+		return reflect.Value{}, false
+	}
+
+	return found, true
+}
@@ -0,0 +1,158 @@
+package sqlx
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/boostgo/errorx"
+)
+
+// pgError mirrors *pgconn.PgError of pgx
+type pgError struct {
+	Code           string
+	ConstraintName string
+}
+
+func (err *pgError) Error() string { return "ERROR (SQLSTATE " + err.Code + ")" }
+
+func (err *pgError) SQLState() string { return err.Code }
+
+// pqErrorCode and pqError mirror pq.ErrorCode and *pq.Error of older pq versions (without SQLState method)
+type pqErrorCode string
+
+type pqError struct {
+	Code       pqErrorCode
+	Constraint string
+}
+
+func (err *pqError) Error() string { return "pq: " + string(err.Code) }
+
+// mysqlError mirrors *mysql.MySQLError
+type mysqlError struct {
+	Number  uint16
+	Message string
+}
+
+func (err *mysqlError) Error() string { return fmt.Sprintf("Error %d: %s", err.Number, err.Message) }
+
+func TestTranslateSQL(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		message    string
+		kind       errorx.Kind
+		retryable  bool
+		state      string
+		constraint string
+	}{
+		{
+			name:       "pgx unique violation",
+			err:        &pgError{Code: "23505", ConstraintName: "users_email_key"},
+			message:    "unique violation",
+			kind:       errorx.KindConflict,
+			state:      "23505",
+			constraint: "users_email_key",
+		},
+		{
+			name:       "pq foreign key violation",
+			err:        &pqError{Code: "23503", Constraint: "orders_user_id_fkey"},
+			message:    "foreign key violation",
+			kind:       errorx.KindInvalid,
+			state:      "23503",
+			constraint: "orders_user_id_fkey",
+		},
+		{
+			name:      "wrapped serialization failure",
+			err:       fmt.Errorf("commit: %w", &pgError{Code: "40001"}),
+			message:   "serialization failure",
+			kind:      errorx.KindConflict,
+			retryable: true,
+			state:     "40001",
+		},
+		{
+			name:      "connection exception class",
+			err:       &pgError{Code: "08006"},
+			message:   "connection loss",
+			kind:      errorx.KindUnavailable,
+			retryable: true,
+			state:     "08006",
+		},
+		{
+			name:    "mysql duplicate entry",
+			err:     &mysqlError{Number: 1062, Message: "Duplicate entry"},
+			message: "unique violation",
+			kind:    errorx.KindConflict,
+		},
+		{
+			name:      "mysql deadlock",
+			err:       errorx.Wrapped("Repo", &mysqlError{Number: 1213}, "update"),
+			message:   "deadlock detected",
+			kind:      errorx.KindConflict,
+			retryable: true,
+		},
+		{
+			name:      "bad connection",
+			err:       driver.ErrBadConn,
+			message:   "connection loss",
+			kind:      errorx.KindUnavailable,
+			retryable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			translated := TranslateSQL(tt.err)
+
+			custom, ok := errorx.TryGet(translated)
+			if !ok {
+				t.Fatalf("TranslateSQL() = %v, want custom error", translated)
+			}
+
+			if custom.Type(1) != Type || custom.Message(1) != tt.message {
+				t.Errorf("TranslateSQL() = [%s] %s, want [%s] %s", custom.Type(1), custom.Message(1), Type, tt.message)
+			}
+
+			if got := errorx.KindOf(translated); got != tt.kind {
+				t.Errorf("KindOf() = %q, want %q", got, tt.kind)
+			}
+
+			if got := errorx.IsRetryable(translated); got != tt.retryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.retryable)
+			}
+
+			if got, _ := errorx.ContextValue(translated, SQLStateKey); tt.state != "" && got != tt.state {
+				t.Errorf("SQLSTATE = %v, want %q", got, tt.state)
+			}
+
+			if got, _ := errorx.ContextValue(translated, ConstraintKey); tt.constraint != "" && got != tt.constraint {
+				t.Errorf("constraint = %v, want %q", got, tt.constraint)
+			}
+
+			// custom errors get new level instead of being wrapped, so driver error is checked
+			driverErr := tt.err
+			if custom, ok := errorx.TryGet(tt.err); ok {
+				driverErr = custom.InnerError()
+			}
+
+			if !errors.Is(translated, driverErr) {
+				t.Errorf("translated error does not wrap driver error")
+			}
+		})
+	}
+
+	t.Run("not recognized error", func(t *testing.T) {
+		for _, err := range []error{errors.New("oops"), &pgError{Code: "XX000"}, &mysqlError{Number: 1}} {
+			if got := TranslateSQL(err); got != err {
+				t.Errorf("TranslateSQL(%v) = %v, want error as is", err, got)
+			}
+		}
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		if got := TranslateSQL(nil); got != nil {
+			t.Errorf("TranslateSQL() = %v, want nil", got)
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package errorx
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Frame is one frame of the stack trace attached to the error
+type Frame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// Frames parses stack trace attached to the error (by CatchPanic for example) into frames.
+//
+// If error has no trace - return empty slice
+func (err *Error) Frames() []Frame {
+	if len(err.trace) == 0 {
+		return []Frame{}
+	}
+
+	return parseStack(strings.Join(err.trace, "\n"))
+}
+
+// parseStack parses trace in format of debug.Stack() output:
+//
+//	goroutine 1 [running]:
+//	main.main()
+//		/path/to/main.go:8 +0x1d
+func parseStack(trace string) []Frame {
+	frames := make([]Frame, 0)
+	lines := strings.Split(trace, "\n")
+
+	var function string
+	for _, line := range lines {
+		if line == "" || strings.HasPrefix(line, "goroutine ") {
+			continue
+		}
+
+		if !strings.HasPrefix(line, "\t") {
+			function = trimFunctionArgs(line)
+			continue
+		}
+
+		if function == "" {
+			continue
+		}
+
+		file, lineNumber := parseFileLine(strings.TrimSpace(line))
+		frames = append(frames, Frame{
+			Function: function,
+			File:     file,
+			Line:     lineNumber,
+		})
+		function = ""
+	}
+
+	return frames
+}
+
+// trimFunctionArgs removes arguments from function line: "main.(*T).Do(0x1, 0x2)" -> "main.(*T).Do"
+func trimFunctionArgs(line string) string {
+	line = strings.TrimPrefix(line, "created by ")
+	if !strings.HasSuffix(line, ")") {
+		return line
+	}
+
+	if index := strings.LastIndex(line, "("); index > 0 {
+		return line[:index]
+	}
+
+	return line
+}
+
+// parseFileLine parses "/path/to/main.go:8 +0x1d" into file path and line number
+func parseFileLine(line string) (string, int) {
+	if index := strings.LastIndex(line, " +0x"); index > 0 {
+		line = line[:index]
+	}
+
+	index := strings.LastIndex(line, ":")
+	if index < 0 {
+		return line, 0
+	}
+
+	lineNumber, err := strconv.Atoi(line[index+1:])
+	if err != nil {
+		return line, 0
+	}
+
+	return line[:index], lineNumber
+}
+
+// callerFunction returns short name of the function (like "pkg.(*Service).Method") which is skip levels above the caller
+func callerFunction(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+
+	function := runtime.FuncForPC(pc)
+	if function == nil {
+		return ""
+	}
+
+	name := function.Name()
+	if index := strings.LastIndex(name, "/"); index >= 0 {
+		name = name[index+1:]
+	}
+
+	return name
+}
@@ -0,0 +1,22 @@
+package errorx
+
+import "slices"
+
+// AddSuppressed appends secondary errors which were suppressed in favor of the current one.
+//
+// Suppressed errors are not part of the chain: they are not unwrapped and not matched by Is
+func (err *Error) AddSuppressed(errs ...error) *Error {
+	err = err.derive()
+	for _, e := range errs {
+		if e != nil {
+			err.suppressed = append(err.suppressed, e)
+		}
+	}
+
+	return err
+}
+
+// Suppressed returns copy of secondary errors which were suppressed in favor of the current one
+func (err *Error) Suppressed() []error {
+	return slices.Clone(err.suppressed)
+}
@@ -0,0 +1,60 @@
+package errorx
+
+import "slices"
+
+// AddTag append tag (lightweight label like "transient" or "user-error") to the current error.
+//
+// Empty and already existing tags are ignored
+func (err *Error) AddTag(tag string) *Error {
+	if tag == "" || slices.Contains(err.tags, tag) {
+		return err
+	}
+
+	err = err.derive()
+	err.tags = append(err.tags, tag)
+	return err
+}
+
+// Tags returns copy of tags of the current error
+func (err *Error) Tags() []string {
+	return slices.Clone(err.tags)
+}
+
+// HasTag checks if any custom error in the chain has provided tag
+func HasTag(err error, tag string) bool {
+	for custom, ok := TryGet(err); ok; custom, ok = TryGet(custom.innerError) {
+		if slices.Contains(custom.tags, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TagsOf returns all tags of all custom errors in the chain
+func TagsOf(err error) []string {
+	tags := make([]string, 0)
+	for custom, ok := TryGet(err); ok; custom, ok = TryGet(custom.innerError) {
+		for _, tag := range custom.tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	return tags
+}
+
+// FilterByTag returns only errors which have provided tag
+func FilterByTag(errs []error, tag string) []error {
+	return Filter(errs, func(err error) bool {
+		return HasTag(err, tag)
+	})
+}
+
+// ExcludeByTag returns only errors which have no provided tag
+func ExcludeByTag(errs []error, tag string) []error {
+	return Filter(errs, func(err error) bool {
+		return !HasTag(err, tag)
+	})
+}
@@ -0,0 +1,93 @@
+package errorx
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Tasks runs functions concurrently and cancels shared context on the first failure.
+//
+// Panics inside functions are recovered and converted to errors.
+// First error is returned by Wait, errors of other failed functions are attached to it as suppressed errors
+type Tasks struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mx         sync.Mutex
+	first      error
+	suppressed []error
+}
+
+// NewTasks creates new tasks runner with shared context derived from the provided one
+func NewTasks(ctx context.Context) *Tasks {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &Tasks{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Go runs provided function in new goroutine with shared context
+func (t *Tasks) Go(fn func(ctx context.Context) error) {
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+
+		t.fail(TryContext(t.ctx, fn))
+	}()
+}
+
+// Wait waits all functions and returns the first error (with suppressed errors of other functions) or nil
+func (t *Tasks) Wait() error {
+	t.wg.Wait()
+	t.cancel()
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.first == nil || len(t.suppressed) == 0 {
+		return t.first
+	}
+
+	return detach(t.first).AddSuppressed(t.suppressed...)
+}
+
+// fail remembers error: first error cancels shared context, others become suppressed.
+//
+// Cancellation errors caused by the first error are ignored
+func (t *Tasks) fail(err error) {
+	if err == nil {
+		return
+	}
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.first == nil {
+		t.first = err
+		t.cancel()
+		return
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	t.suppressed = append(t.suppressed, err)
+}
+
+// RunTasks runs all provided functions concurrently (see Tasks) and waits them
+func RunTasks(ctx context.Context, fns ...func(ctx context.Context) error) error {
+	tasks := NewTasks(ctx)
+	for _, fn := range fns {
+		tasks.Go(fn)
+	}
+
+	return tasks.Wait()
+}
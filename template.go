@@ -0,0 +1,96 @@
+package errorx
+
+import (
+	"slices"
+	"strings"
+	"sync/atomic"
+	"text/template"
+)
+
+var formatTemplate atomic.Pointer[template.Template]
+
+// FormatData is data which is passed to the format template (see SetFormatTemplate)
+type FormatData struct {
+	// Types are types of the error from outer level to inner one
+	Types []string
+	// Messages are messages of the error from outer level to inner one
+	Messages []string
+	// Type is joined types, like Type() returns
+	Type string
+	// Message is joined messages, like Message() returns
+	Message string
+	Code    Code
+	Kind    Kind
+	Tags    []string
+	// Context is context of the error with masked sensitive values
+	Context map[string]any
+	// Inner is string representation of the inner error
+	Inner string
+	Trace []string
+}
+
+// SetFormatTemplate sets text/template which is used by Error() method for rendering errors.
+//
+// Template gets FormatData as data:
+//
+//	errorx.SetFormatTemplate(`{{ .Type }}: {{ .Message }}{{ if .Inner }} ({{ .Inner }}){{ end }}`)
+//
+// Calling with empty template restores default rendering (see String())
+func SetFormatTemplate(tmpl string) error {
+	if tmpl == "" {
+		formatTemplate.Store(nil)
+		return nil
+	}
+
+	parsed, err := template.New("errorx").Parse(tmpl)
+	if err != nil {
+		return New("parse format template").
+			SetType("errorx").
+			SetError(err)
+	}
+
+	formatTemplate.Store(parsed)
+	return nil
+}
+
+// formatData collects data for the format template
+func (err *Error) formatData() FormatData {
+	types := err.types()
+	slices.Reverse(types)
+
+	messages := err.messages()
+	slices.Reverse(messages)
+
+	data := FormatData{
+		Types:    types,
+		Messages: messages,
+		Type:     err.Type(),
+		Message:  err.Message(),
+		Code:     err.code,
+		Kind:     err.kind,
+		Tags:     slices.Clone(err.tags),
+		Context:  redactContext(err.contextMap()),
+		Trace:    slices.Clone(err.renderedTrace()),
+	}
+
+	if err.innerError != nil {
+		data.Inner = err.innerError.Error()
+	}
+
+	return data
+}
+
+// executeTemplate renders error by format template. Returns false if template is not set or rendering failed
+func (err *Error) executeTemplate() (string, bool) {
+	tmpl := formatTemplate.Load()
+	if tmpl == nil {
+		return "", false
+	}
+
+	builder := strings.Builder{}
+	if e := tmpl.Execute(&builder, err.formatData()); e != nil {
+		return "", false
+	}
+
+	return builder.String(), true
+}
@@ -0,0 +1,52 @@
+package errorx
+
+import (
+	"context"
+	"errors"
+)
+
+// Temporary implements informal interface{ Temporary() bool } (like net.Error).
+//
+// Error is temporary if it is retryable (see IsRetryable) or any inner error reports it is temporary
+func (err *Error) Temporary() bool {
+	if IsRetryable(err) {
+		return true
+	}
+
+	return err.innerReports(func(inner error) bool {
+		temporary, ok := inner.(interface{ Temporary() bool })
+		return ok && temporary.Temporary()
+	})
+}
+
+// Timeout implements informal interface{ Timeout() bool } (like net.Error).
+//
+// Error is timeout if its kind is KindTimeout, it wraps context.DeadlineExceeded
+// or any inner error reports it is timeout
+func (err *Error) Timeout() bool {
+	if KindOf(err) == KindTimeout || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	return err.innerReports(func(inner error) bool {
+		timeout, ok := inner.(interface{ Timeout() bool })
+		return ok && timeout.Timeout()
+	})
+}
+
+// innerReports checks if any not custom error in the chain satisfies provided predicate.
+//
+// Custom errors are skipped because their methods are driven by kind and flags
+func (err *Error) innerReports(pred func(error) bool) bool {
+	reported := false
+	walkChain(err.innerError, func(inner error) bool {
+		if _, ok := inner.(*Error); ok {
+			return true
+		}
+
+		reported = pred(inner)
+		return !reported
+	})
+
+	return reported
+}
@@ -0,0 +1,37 @@
+package errorx
+
+import "time"
+
+const (
+	ElapsedKey = "elapsed"
+)
+
+// CreatedAt returns time when error was created
+func (err *Error) CreatedAt() time.Time {
+	return err.createdAt
+}
+
+// Age returns duration since error was created
+func (err *Error) Age() time.Duration {
+	if err.createdAt.IsZero() {
+		return 0
+	}
+
+	return time.Since(err.createdAt)
+}
+
+// Elapsed append duration since provided time to the context by ElapsedKey.
+//
+// Useful for recording latency at the moment of failure:
+//
+//	start := time.Now()
+//	...
+//	return errorx.New("query timeout").Elapsed(start)
+func (err *Error) Elapsed(since time.Time) *Error {
+	return err.AddDuration(ElapsedKey, time.Since(since))
+}
+
+// AddDuration append duration to the context in human-readable format, like "1.5s"
+func (err *Error) AddDuration(key string, duration time.Duration) *Error {
+	return err.AddContext(key, duration.String())
+}
@@ -0,0 +1,100 @@
+package errorx
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MessageTemplate is reusable error definition with formatted message.
+//
+// All errors created by template share its identity (see Define), type, code and kind,
+// but every error has its own formatted message and context:
+//
+//	var ErrUserNotFound = errorx.Template("user %d not found").
+//		SetType("User Repository").
+//		SetCode("USER_NOT_FOUND")
+//
+//	return ErrUserNotFound.New(id)
+//	...
+//	errors.Is(err, ErrUserNotFound.Sentinel()) // true
+//	ErrUserNotFound.Match(err)                 // true
+type MessageTemplate struct {
+	format  string
+	defined *Error
+}
+
+// Template creates reusable error definition with message format (in fmt.Sprintf style)
+func Template(format string) *MessageTemplate {
+	return &MessageTemplate{
+		format:  format,
+		defined: Define(format),
+	}
+}
+
+// SetType sets type of every error created by template
+func (t *MessageTemplate) SetType(errType string) *MessageTemplate {
+	t.defined = t.defined.SetType(errType)
+	return t
+}
+
+// SetCode sets code of every error created by template
+func (t *MessageTemplate) SetCode(code Code) *MessageTemplate {
+	t.defined = t.defined.SetCode(code)
+	return t
+}
+
+// SetKind sets kind of every error created by template
+func (t *MessageTemplate) SetKind(kind Kind) *MessageTemplate {
+	t.defined = t.defined.SetKind(kind)
+	return t
+}
+
+// AddTag adds tag to every error created by template
+func (t *MessageTemplate) AddTag(tag string) *MessageTemplate {
+	t.defined = t.defined.AddTag(tag)
+	return t
+}
+
+// New creates new error with message formatted by provided arguments.
+//
+// If args capturing is enabled (see SetCaptureArgs) - arguments are stored in context by ArgsKey
+func (t *MessageTemplate) New(args ...any) *Error {
+	return notifyCreate(t.new(args...))
+}
+
+// new creates new error by template without calling create hooks
+func (t *MessageTemplate) new(args ...any) *Error {
+	err := t.defined.clone()
+	for i := range err.levels {
+		err.levels[i].message = ""
+	}
+	err.levels[len(err.levels)-1].message = fmt.Sprintf(t.format, args...)
+	err.createdAt = time.Now()
+
+	if captureArgs.Load() && len(args) > 0 {
+		err.setContextValue(ArgsKey, args)
+	}
+
+	return err
+}
+
+// Wrap creates new error with formatted message and sets provided error as inner one
+func (t *MessageTemplate) Wrap(inner error, args ...any) *Error {
+	return notifyCreate(t.new(args...).SetError(inner))
+}
+
+// Sentinel returns defined error which is shared by all errors of the template. Use it as target of errors.Is
+func (t *MessageTemplate) Sentinel() *Error {
+	return t.defined
+}
+
+// Match returns true if provided error (or any error in its chain) was created by template
+func (t *MessageTemplate) Match(err error) bool {
+	return errors.Is(err, t.defined)
+}
+
+// Format returns message format of the template
+func (t *MessageTemplate) Format() string {
+	return t.format
+}
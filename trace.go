@@ -0,0 +1,64 @@
+package errorx
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/boostgo/convert"
+)
+
+const (
+	// traceKey is old-style context key which was used for storing trace
+	traceKey = "trace"
+)
+
+// Trace returns copy of trace lines attached to the error
+func (err *Error) Trace() []string {
+	return slices.Clone(err.trace)
+}
+
+// SetTrace sets trace of the error. Multi-line strings are split into separate lines.
+//
+// Calling without lines clears trace
+func (err *Error) SetTrace(trace ...string) *Error {
+	err = err.derive()
+	err.setTrace(trace...)
+	return err
+}
+
+// setTrace sets trace lines of the current error (without copying)
+func (err *Error) setTrace(trace ...string) {
+	lines := make([]string, 0, len(trace))
+	for _, line := range trace {
+		if line == "" {
+			continue
+		}
+
+		lines = append(lines, strings.Split(strings.TrimRight(line, "\n"), "\n")...)
+	}
+
+	err.trace = lines
+}
+
+// HasTrace returns true if error has trace
+func (err *Error) HasTrace() bool {
+	return len(err.trace) > 0
+}
+
+// migrateTrace sets old-style "trace" context value as trace of the error.
+//
+// Deprecated: kept for compatibility with code which attach trace by AddContext("trace", ...). Use SetTrace instead
+func (err *Error) migrateTrace(value any) {
+	switch trace := value.(type) {
+	case string:
+		err.setTrace(trace)
+	case []string:
+		if len(trace) == 0 {
+			return
+		}
+
+		err.setTrace(trace...)
+	default:
+		err.setTrace(convert.String(value))
+	}
+}
@@ -0,0 +1,35 @@
+package errorx
+
+import (
+	"strconv"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+var maxLength atomic.Int64
+
+// SetMaxLength sets maximum length (in bytes) of String() output.
+//
+// Longer output is cut and ends with "…truncated N bytes" marker. Zero or negative value disables limit (default)
+func SetMaxLength(length int) {
+	if length < 0 {
+		length = 0
+	}
+
+	maxLength.Store(int64(length))
+}
+
+// truncate cuts text by max length without breaking UTF-8 runes
+func truncate(text string) string {
+	limit := int(maxLength.Load())
+	if limit <= 0 || len(text) <= limit {
+		return text
+	}
+
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+
+	return text[:cut] + "…truncated " + strconv.Itoa(len(text)-cut) + " bytes"
+}
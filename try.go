@@ -3,7 +3,7 @@ package errorx
 import (
 	"context"
 	"errors"
-	"runtime/debug"
+	"time"
 
 	"github.com/boostgo/convert"
 )
@@ -37,13 +37,110 @@ func TryMust(tryFunc func() error) {
 	_ = Try(tryFunc)
 }
 
-// CatchPanic got recover() return value and convert it to error
+// TryReturn is like Try but provided function returns value.
+//
+// If panic was thrown - zero value and recover error are returned
+func TryReturn[T any](fn func() (T, error)) (result T, err error) {
+	defer func() {
+		if err == nil {
+			if err = CatchPanic(recover()); err != nil {
+				var zero T
+				result = zero
+			}
+		}
+	}()
+
+	return fn()
+}
+
+// TryReturnContext is like TryReturn but provided function has context as an argument
+func TryReturnContext[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) (T, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return TryReturn(func() (T, error) {
+		return fn(ctx)
+	})
+}
+
+// TryTimeout runs provided function with deadline and recovers panic like Try.
+//
+// If function does not finish in time, error with KindTimeout is returned (function keeps running in background,
+// so it should respect context cancellation)
+func TryTimeout(timeout time.Duration, fn func(ctx context.Context) error) error {
+	return TryTimeoutContext(context.Background(), timeout, fn)
+}
+
+// TryTimeoutContext is like TryTimeout but deadline context is derived from provided context
+func TryTimeoutContext(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := make(chan error, 1)
+	go func() {
+		result <- TryContext(ctx, fn)
+	}()
+
+	select {
+	case err := <-result:
+		if err != nil && errors.Is(err, context.DeadlineExceeded) && KindOf(err) == KindUnknown {
+			return newTimeoutError(err, timeout)
+		}
+
+		return err
+	case <-ctx.Done():
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return ctx.Err()
+		}
+
+		return newTimeoutError(ctx.Err(), timeout)
+	}
+}
+
+// newTimeoutError creates error with KindTimeout which wraps provided error
+func newTimeoutError(err error, timeout time.Duration) error {
+	return New("timeout exceeded").
+		SetKind(KindTimeout).
+		SetError(err).
+		AddDuration("timeout", timeout)
+}
+
+// CatchPanic got recover() return value and convert it to error.
+//
+// If recovered value is error - it is kept as inner error, so it could be matched by Is.
+// Registered panic hooks are called with recovered value and stack (see OnPanic).
+// If recovered value matches re-panic policy, it is panicked again (see SetRepanicPolicy)
 func CatchPanic(err any) error {
+	return catchPanic(err, true)
+}
+
+// catchPanic converts recover() return value to error and optionally calls panic hooks
+func catchPanic(err any, notify bool) error {
 	if err == nil {
 		return nil
 	}
 
-	return New("PANIC RECOVER").
-		SetError(errors.New(convert.String(err))).
-		AddContext("trace", convert.String(debug.Stack()))
+	inner, ok := err.(error)
+	if !ok {
+		inner = errors.New(convert.String(err))
+	}
+
+	recovered := New("PANIC RECOVER").
+		SetError(inner).
+		SetTrace(captureStack())
+
+	if notify {
+		notifyPanic(err, recovered.Frames())
+	}
+
+	if mustRepanic(err) {
+		panic(err)
+	}
+
+	return recovered
 }
@@ -0,0 +1,92 @@
+package errorx
+
+import (
+	"slices"
+	"strings"
+	"unique"
+)
+
+const (
+	typePatternSeparator = "/"
+)
+
+// internType returns canonical copy of the type string.
+//
+// Types come from small fixed set per program, so identical types of millions of errors share one string in memory
+func internType(errorType string) string {
+	return unique.Make(errorType).Value()
+}
+
+// HasType checks if type chain of the error matches provided pattern.
+//
+// Pattern matches against individual levels of the type chain (from outer level to inner one), not joined string.
+// Wildcard "*" matches any sequence of characters inside level. Levels in pattern separated by "/"
+// and must follow each other in the chain:
+//
+//	"SQL"                      - any level is "SQL"
+//	"User *"                   - any level starts with "User "
+//	"*/SQL"                    - "SQL" level has level before it
+//	"User Usecase/User *"      - "User Usecase" level followed by level which starts with "User "
+func HasType(err error, pattern string) bool {
+	if pattern == "" {
+		return false
+	}
+
+	levels := typeLevels(err)
+	segments := strings.Split(pattern, typePatternSeparator)
+	if len(segments) > len(levels) {
+		return false
+	}
+
+	for start := 0; start+len(segments) <= len(levels); start++ {
+		matched := true
+		for i, segment := range segments {
+			if !matchWildcard(strings.TrimSpace(segment), levels[start+i]) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// typeLevels returns all type levels of the custom errors chain from outer level to inner one
+func typeLevels(err error) []string {
+	levels := make([]string, 0)
+	for custom, ok := TryGet(err); ok; custom, ok = TryGet(custom.innerError) {
+		types := custom.types()
+		slices.Reverse(types)
+		levels = append(levels, types...)
+	}
+
+	return levels
+}
+
+// matchWildcard matches provided value with pattern where "*" matches any sequence of characters
+func matchWildcard(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(value, part)
+		if index < 0 {
+			return false
+		}
+
+		value = value[index+len(part):]
+	}
+
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
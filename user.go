@@ -0,0 +1,116 @@
+package errorx
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Catalog translates user message keys into localized messages
+type Catalog interface {
+	// Translate returns message by provided language and key. If there is no translation - return false
+	Translate(lang, key string, args ...any) (string, bool)
+}
+
+// MapCatalog is simple catalog in format "language -> key -> message".
+//
+// Message could contain fmt verbs which are filled by arguments of the user message.
+// If there is no translation for the regional language ("en-US") - base language ("en") is used
+type MapCatalog map[string]map[string]string
+
+// Translate returns message by provided language and key
+func (catalog MapCatalog) Translate(lang, key string, args ...any) (string, bool) {
+	messages, ok := catalog[lang]
+	if !ok {
+		if index := strings.IndexAny(lang, "-_"); index > 0 {
+			messages, ok = catalog[lang[:index]]
+		}
+	}
+
+	if !ok {
+		return "", false
+	}
+
+	message, ok := messages[key]
+	if !ok {
+		return "", false
+	}
+
+	return formatUserMessage(message, args), true
+}
+
+// userMessage is end-user message key with its arguments
+type userMessage struct {
+	key  string
+	args []any
+}
+
+var catalog atomic.Pointer[Catalog]
+
+// SetCatalog sets global catalog of user messages translations
+func SetCatalog(c Catalog) {
+	if c == nil {
+		catalog.Store(nil)
+		return
+	}
+
+	catalog.Store(&c)
+}
+
+// SetUserMessage sets end-user appropriate message key with arguments.
+//
+// Key is translated by catalog (see SetCatalog) on rendering, internal messages of the error stay untouched
+func (err *Error) SetUserMessage(key string, args ...any) *Error {
+	err = err.derive()
+	err.userMessage = &userMessage{
+		key:  key,
+		args: args,
+	}
+	return err
+}
+
+// UserMessage returns translated user message of the current error.
+//
+// If there is no user message in current error - inner errors are checked.
+// If there is no translation - key is returned
+func (err *Error) UserMessage(lang string) string {
+	return UserMessage(err, lang)
+}
+
+// UserMessage returns translated user message found in the chain of custom errors (outer first).
+//
+// If there is no user message - return empty string.
+// If there is no translation - key is returned
+func UserMessage(err error, lang string) string {
+	key, args, ok := UserMessageKey(err)
+	if !ok {
+		return ""
+	}
+
+	if c := catalog.Load(); c != nil {
+		if message, translated := (*c).Translate(lang, key, args...); translated {
+			return message
+		}
+	}
+
+	return key
+}
+
+// UserMessageKey returns user message key with arguments found in the chain of custom errors (outer first)
+func UserMessageKey(err error) (string, []any, bool) {
+	for custom, ok := TryGet(err); ok; custom, ok = TryGet(custom.innerError) {
+		if custom.userMessage != nil {
+			return custom.userMessage.key, custom.userMessage.args, true
+		}
+	}
+
+	return "", nil, false
+}
+
+func formatUserMessage(message string, args []any) string {
+	if len(args) == 0 {
+		return message
+	}
+
+	return fmt.Sprintf(message, args...)
+}
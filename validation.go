@@ -0,0 +1,80 @@
+package errorx
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// ValidationType is type of errors created by Validation
+	ValidationType = "Validation"
+
+	// CodeValidation is code of errors created by Validation
+	CodeValidation Code = "VALIDATION_FAILED"
+)
+
+// FieldError describes invalid field of the request
+type FieldError struct {
+	// Field is path to the field in JSON notation: dotted for nested structs and bracketed for indexes,
+	// like "user.email" or "items[2].price" (see FieldPath)
+	Field string `json:"field"`
+	// Code is stable machine-readable code of the failed rule, like "required"
+	Code string `json:"code"`
+	// Message is human-readable description of the problem
+	Message string `json:"message"`
+}
+
+// Error returns field error as string: "field: message"
+func (f FieldError) Error() string {
+	if f.Field == "" {
+		return f.Message
+	}
+
+	return f.Field + ": " + f.Message
+}
+
+// ValidationError is list of invalid fields
+type ValidationError struct {
+	Fields []FieldError `json:"fields"`
+}
+
+// Error returns all field errors joined by "; "
+func (err *ValidationError) Error() string {
+	builder := strings.Builder{}
+	for i, field := range err.Fields {
+		if i > 0 {
+			builder.WriteString("; ")
+		}
+
+		builder.WriteString(field.Error())
+	}
+
+	return builder.String()
+}
+
+// Validation creates custom error with ValidationType type, KindInvalid kind and CodeValidation code
+// which wraps ValidationError with provided fields.
+//
+// If no fields provided - return nil
+func Validation(fields ...FieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return notifyCreate(newMessage("validation failed").
+		SetType(ValidationType).
+		SetKind(KindInvalid).
+		SetCode(CodeValidation).
+		SetUserMessage("validation failed").
+		SetError(&ValidationError{Fields: fields}))
+}
+
+// FieldErrors returns field errors of the first ValidationError found in the chain
+func FieldErrors(err error) []FieldError {
+	var validation *ValidationError
+	if !errors.As(err, &validation) {
+		return nil
+	}
+
+	return validation.Fields
+}
@@ -0,0 +1,77 @@
+package errorx
+
+import "slices"
+
+const (
+	// CodeInvalidField is code of field errors added by Validator.Check
+	CodeInvalidField = "invalid"
+)
+
+// Validator accumulates field errors, so validation code reads linearly and returns single validation error.
+//
+// Zero value is ready to use:
+//
+//	var v errorx.Validator
+//	v.Check(request.Email != "", "email", "is required")
+//	v.Check(request.Age >= 18, "age", "must be at least 18")
+//	for i, item := range request.Items {
+//		v.Nested(errorx.FieldPath("items", i), validateItem(item))
+//	}
+//	return v.Err()
+//
+// Validator is not safe for concurrent use
+type Validator struct {
+	fields []FieldError
+}
+
+// Check adds field error with CodeInvalidField code if condition is false. Returns condition
+func (v *Validator) Check(cond bool, field, message string) bool {
+	if !cond {
+		v.fields = append(v.fields, FieldError{
+			Field:   field,
+			Code:    CodeInvalidField,
+			Message: message,
+		})
+	}
+
+	return cond
+}
+
+// Add adds field errors
+func (v *Validator) Add(fields ...FieldError) {
+	v.fields = append(v.fields, fields...)
+}
+
+// Nested adds field errors of nested validation error prefixed by provided path (see PrefixFields).
+//
+// Not validation errors are added as field error of provided path with error text as message
+func (v *Validator) Nested(path string, err error) {
+	if err == nil {
+		return
+	}
+
+	fields := FieldErrors(err)
+	if len(fields) == 0 {
+		v.Check(false, path, err.Error())
+		return
+	}
+
+	for _, field := range fields {
+		v.fields = append(v.fields, field.Prefix(path))
+	}
+}
+
+// Valid checks if no field errors were accumulated
+func (v *Validator) Valid() bool {
+	return len(v.fields) == 0
+}
+
+// Fields returns accumulated field errors
+func (v *Validator) Fields() []FieldError {
+	return slices.Clone(v.fields)
+}
+
+// Err returns validation error with all accumulated field errors (see Validation) or nil if there are no errors
+func (v *Validator) Err() error {
+	return Validation(slices.Clone(v.fields)...)
+}
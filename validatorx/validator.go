@@ -0,0 +1,204 @@
+package validatorx
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/boostgo/errorx"
+)
+
+// fieldError is validator.FieldError
+type fieldError interface {
+	Tag() string
+	Param() string
+	Namespace() string
+	StructNamespace() string
+}
+
+// codes are stable codes of validator rules
+var codes = map[string]string{
+	"required":             "required",
+	"required_if":          "required",
+	"required_unless":      "required",
+	"required_with":        "required",
+	"required_with_all":    "required",
+	"required_without":     "required",
+	"required_without_all": "required",
+	"email":                "invalid_email",
+	"url":                  "invalid_url",
+	"http_url":             "invalid_url",
+	"uri":                  "invalid_url",
+	"uuid":                 "invalid_uuid",
+	"uuid4":                "invalid_uuid",
+	"min":                  "too_small",
+	"gte":                  "too_small",
+	"gt":                   "too_small",
+	"max":                  "too_large",
+	"lte":                  "too_large",
+	"lt":                   "too_large",
+	"len":                  "invalid_length",
+	"oneof":                "not_allowed",
+	"eqfield":              "mismatch",
+	"datetime":             "invalid_format",
+	"numeric":              "invalid_format",
+	"number":               "invalid_format",
+	"alpha":                "invalid_format",
+	"alphanum":             "invalid_format",
+	"e164":                 "invalid_phone",
+}
+
+// Translate converts validator.ValidationErrors (github.com/go-playground/validator) to errorx.Validation error.
+//
+// Struct namespaces are translated to JSON field paths ("User.Items[2].UnitPrice" -> "items[2].unit_price")
+// by "json" tags of provided validated value. If value is nil - namespaces are used as is (without root struct name),
+// so they should be registered by validator.RegisterTagNameFunc. Rules are translated to stable codes
+// ("required", "too_small", "invalid_email"), unknown rules keep validator tag as code.
+//
+// Package does not depend on validator, errors are recognized by their methods.
+// If error is not validation errors - it is returned as is. If provided error is nil - return nil
+func Translate(err error, value any) error {
+	if err == nil {
+		return nil
+	}
+
+	reflected := reflect.ValueOf(err)
+	if reflected.Kind() != reflect.Slice {
+		return err
+	}
+
+	fields := make([]errorx.FieldError, 0, reflected.Len())
+	for i := 0; i < reflected.Len(); i++ {
+		field, ok := reflected.Index(i).Interface().(fieldError)
+		if !ok {
+			return err
+		}
+
+		fields = append(fields, errorx.FieldError{
+			Field:   path(field, value),
+			Code:    code(field.Tag()),
+			Message: message(field.Tag(), field.Param()),
+		})
+	}
+
+	return errorx.Validation(fields...)
+}
+
+// code returns stable code of validator rule
+func code(tag string) string {
+	if known, ok := codes[tag]; ok {
+		return known
+	}
+
+	return tag
+}
+
+// message returns human-readable description of failed rule
+func message(tag, param string) string {
+	switch code(tag) {
+	case "required":
+		return "is required"
+	case "invalid_email":
+		return "must be a valid email"
+	case "invalid_url":
+		return "must be a valid URL"
+	case "invalid_uuid":
+		return "must be a valid UUID"
+	case "invalid_phone":
+		return "must be a valid phone number"
+	case "too_small":
+		return fmt.Sprintf("must be at least %s", param)
+	case "too_large":
+		return fmt.Sprintf("must be at most %s", param)
+	case "invalid_length":
+		return fmt.Sprintf("must have length %s", param)
+	case "not_allowed":
+		return fmt.Sprintf("must be one of: %s", strings.Join(strings.Fields(param), ", "))
+	case "mismatch":
+		return fmt.Sprintf("must match %s", param)
+	case "invalid_format":
+		return "has invalid format"
+	}
+
+	if param != "" {
+		return fmt.Sprintf("failed %q rule (%s)", tag, param)
+	}
+
+	return fmt.Sprintf("failed %q rule", tag)
+}
+
+// path translates struct namespace of the field to JSON path
+func path(field fieldError, value any) string {
+	if value == nil {
+		return trimRoot(field.Namespace())
+	}
+
+	typ := reflect.TypeOf(value)
+	segments := strings.Split(trimRoot(field.StructNamespace()), ".")
+	names := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		name, index, _ := strings.Cut(segment, "[")
+		if index != "" {
+			index = "[" + index
+		}
+
+		typ = deref(typ)
+		if typ == nil || typ.Kind() != reflect.Struct {
+			names = append(names, segment)
+			typ = nil
+			continue
+		}
+
+		structField, ok := typ.FieldByName(name)
+		if !ok {
+			names = append(names, segment)
+			typ = nil
+			continue
+		}
+
+		names = append(names, jsonName(structField)+index)
+		typ = structField.Type
+		for range strings.Count(index, "[") {
+			if typ = deref(typ); typ == nil {
+				break
+			}
+
+			switch typ.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				typ = typ.Elem()
+			default:
+				typ = nil
+			}
+		}
+	}
+
+	return strings.Join(names, ".")
+}
+
+// trimRoot removes name of the validated struct from namespace: "User.Email" -> "Email"
+func trimRoot(namespace string) string {
+	if _, field, ok := strings.Cut(namespace, "."); ok {
+		return field
+	}
+
+	return namespace
+}
+
+// jsonName returns name of struct field in JSON
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
+
+// deref returns type which pointer type points to
+func deref(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
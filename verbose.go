@@ -0,0 +1,110 @@
+package errorx
+
+import (
+	"strings"
+)
+
+const (
+	verboseIndent = "  "
+)
+
+// style describes how parts of the verbose representation are decorated
+type style struct {
+	errorType func(string) string
+	message   func(string) string
+	key       func(string) string
+}
+
+// plainStyle does not decorate anything
+var plainStyle = style{
+	errorType: func(s string) string { return s },
+	message:   func(s string) string { return s },
+	key:       func(s string) string { return s },
+}
+
+// Verbose returns multi-line representation of the error as indented tree.
+//
+// Every wrap layer is printed on its own line with its type and message, inner layers are nested below outer ones.
+// Context is printed below the layer which added it and inner errors are nested below layers:
+//
+//	[User Usecase] get user
+//	  context: user_id=1;
+//	  [User Repository] get by id
+//	    [SQL] query
+//	      context: query=select;
+//	      sql: no rows in result set
+func (err *Error) Verbose() string {
+	builder := strings.Builder{}
+	writeVerbose(&builder, err, 0, plainStyle)
+	return strings.TrimRight(builder.String(), "\n")
+}
+
+// writeVerbose writes error tree into builder starting from provided depth
+func writeVerbose(builder *strings.Builder, err error, depth int, s style) {
+	custom, ok := err.(*Error)
+	if !ok {
+		if isMultiError(err) {
+			for _, inner := range innerErrors(err) {
+				writeVerbose(builder, inner, depth, s)
+			}
+			return
+		}
+
+		writeVerboseLine(builder, depth, s.message(err.Error()))
+		return
+	}
+
+	for i := custom.layerCount() - 1; i >= 0; i-- {
+		errType, message := custom.levels[i].errType, custom.levels[i].message
+		parts := make([]string, 0, 2)
+		if errType != "" {
+			parts = append(parts, s.errorType("["+errType+"]"))
+		}
+
+		if message != "" {
+			parts = append(parts, s.message(message))
+		}
+
+		writeVerboseLine(builder, depth, strings.Join(parts, " "))
+		depth++
+
+		if layer := custom.layer(i); len(layer.Keys) > 0 {
+			writeVerboseContext(builder, depth, layer, s)
+		}
+	}
+
+	if custom.innerError != nil {
+		if custom.cyclic() {
+			writeVerboseLine(builder, depth, s.message(cycleMessage))
+			return
+		}
+
+		writeVerbose(builder, custom.innerError, depth, s)
+	}
+}
+
+// writeVerboseContext writes context added on the wrap level
+func writeVerboseContext(builder *strings.Builder, depth int, layer Layer, s style) {
+	line := strings.Builder{}
+	line.WriteString(s.key("context:"))
+	context := redactContext(layer.Context)
+	for _, key := range layer.Keys {
+		line.WriteString(" ")
+		line.WriteString(s.key(key))
+		line.WriteString("=")
+		line.WriteString(contextValueString(context[key]))
+		line.WriteString(";")
+	}
+
+	writeVerboseLine(builder, depth, line.String())
+}
+
+// writeVerboseLine writes indented line. Multi-line text is indented on every line
+func writeVerboseLine(builder *strings.Builder, depth int, text string) {
+	indent := strings.Repeat(verboseIndent, depth)
+	for _, line := range strings.Split(text, "\n") {
+		builder.WriteString(indent)
+		builder.WriteString(line)
+		builder.WriteString("\n")
+	}
+}
@@ -0,0 +1,64 @@
+package errorx
+
+const (
+	JobIDKey = "job_id"
+)
+
+// WorkerOption configures SafeWorker
+type WorkerOption[T any] func(options *workerOptions[T])
+
+type workerOptions[T any] struct {
+	jobID  func(job T) string
+	notify bool
+}
+
+// WithJobID sets extractor of job identifier. Identifier is attached to the error context by JobIDKey
+func WithJobID[T any](extract func(job T) string) WorkerOption[T] {
+	return func(options *workerOptions[T]) {
+		options.jobID = extract
+	}
+}
+
+// WithoutPanicHooks disables calling of panic hooks (see OnPanic) for panics recovered in worker
+func WithoutPanicHooks[T any]() WorkerOption[T] {
+	return func(options *workerOptions[T]) {
+		options.notify = false
+	}
+}
+
+// SafeWorker wraps worker-pool job handler: panic of every job is recovered and converted to error,
+// errors are tagged by job identifier (see WithJobID).
+//
+//	handle := errorx.SafeWorker(func(job Job) error {
+//		return process(job)
+//	}, errorx.WithJobID(func(job Job) string {
+//		return job.ID
+//	}))
+//
+//	for job := range jobs {
+//		if err := handle(job); err != nil {
+//			log.Println(err)
+//		}
+//	}
+func SafeWorker[T any](fn func(job T) error, opts ...WorkerOption[T]) func(job T) error {
+	options := workerOptions[T]{
+		notify: true,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return func(job T) (err error) {
+		defer func() {
+			if err == nil {
+				err = catchPanic(recover(), options.notify)
+			}
+
+			if err != nil && options.jobID != nil {
+				err = detach(err).AddContext(JobIDKey, options.jobID(job))
+			}
+		}()
+
+		return fn(job)
+	}
+}